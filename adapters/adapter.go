@@ -47,6 +47,15 @@ func For(task models.TaskSpec, store *store.Store) (AdapterWithMinConfs, error)
 	case "httppost":
 		ac = &HTTPPost{}
 		err = unmarshalParams(task.Params, ac)
+	case "httpput":
+		ac = &HTTPPut{}
+		err = unmarshalParams(task.Params, ac)
+	case "httppatch":
+		ac = &HTTPPatch{}
+		err = unmarshalParams(task.Params, ac)
+	case "httpdelete":
+		ac = &HTTPDelete{}
+		err = unmarshalParams(task.Params, ac)
 	case "jsonparse":
 		ac = &JSONParse{}
 		err = unmarshalParams(task.Params, ac)
@@ -68,6 +77,15 @@ func For(task models.TaskSpec, store *store.Store) (AdapterWithMinConfs, error)
 	case "multiply":
 		ac = &Multiply{}
 		err = unmarshalParams(task.Params, ac)
+	case "median":
+		ac = &Median{}
+		err = unmarshalParams(task.Params, ac)
+	case "mean":
+		ac = &Mean{}
+		err = unmarshalParams(task.Params, ac)
+	case "trimmedmean":
+		ac = &TrimmedMean{}
+		err = unmarshalParams(task.Params, ac)
 	case "noop":
 		ac = &NoOp{}
 		err = unmarshalParams(task.Params, ac)
@@ -79,7 +97,7 @@ func For(task models.TaskSpec, store *store.Store) (AdapterWithMinConfs, error)
 		if err != nil {
 			return nil, fmt.Errorf("%s is not a supported adapter type", task.Type)
 		}
-		return &Bridge{bt}, nil
+		return &Bridge{BridgeType: bt}, nil
 	}
 	wa := MinConfsWrappedAdapter{
 		Adapter:                 ac,
@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/tidwall/gjson"
+)
+
+// Aggregate holds the settings shared by the adapters which reduce a list of
+// values to a single value.
+//
+// The values are read from the input at Path, which defaults to "results",
+// the list a task with several parents receives. Null values are treated as
+// missing responses. When MaxDeviation is set, values deviating from the
+// median by more than that percentage are discarded. The run errors if fewer
+// than MinResponses values remain.
+type Aggregate struct {
+	Path         string  `json:"path"`
+	MinResponses int     `json:"minResponses"`
+	MaxDeviation float64 `json:"maxDeviation"`
+}
+
+// Median reduces a list of values to their median.
+type Median struct {
+	Aggregate
+}
+
+// Perform returns the median of the input values.
+//
+// For example, given the values ["100", "102", "101.5"] the result's value
+// will be "101.5".
+func (ma *Median) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	values, err := ma.values(input)
+	if err != nil {
+		return input.WithError(err)
+	}
+	return input.WithValue(median(values).Text('f', -1))
+}
+
+// Mean reduces a list of values to their arithmetic mean.
+type Mean struct {
+	Aggregate
+}
+
+// Perform returns the mean of the input values.
+//
+// For example, given the values ["100", "102", "101"] the result's value
+// will be "101".
+func (ma *Mean) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	values, err := ma.values(input)
+	if err != nil {
+		return input.WithError(err)
+	}
+	return input.WithValue(mean(values).Text('f', -1))
+}
+
+// TrimmedMean reduces a list of values to their mean after dropping the
+// Trim percentage of the lowest and of the highest values.
+type TrimmedMean struct {
+	Aggregate
+	Trim float64 `json:"trim"`
+}
+
+// Perform returns the trimmed mean of the input values.
+//
+// For example, given the values ["1", "100", "101", "102", "500"] and a
+// "trim" of 20, the result's value will be "101".
+func (ma *TrimmedMean) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	if ma.Trim < 0 || ma.Trim >= 50 {
+		return input.WithError(fmt.Errorf("trim must be at least 0 and below 50, got %v", ma.Trim))
+	}
+
+	values, err := ma.values(input)
+	if err != nil {
+		return input.WithError(err)
+	}
+
+	cut := int(float64(len(values)) * ma.Trim / 100)
+	return input.WithValue(mean(values[cut:len(values)-cut]).Text('f', -1))
+}
+
+// values returns the sorted input values that are within the maximum
+// deviation, erroring when there are fewer than the minimum responses.
+func (a Aggregate) values(input models.RunResult) ([]*big.Float, error) {
+	path := a.Path
+	if path == "" {
+		path = "results"
+	}
+
+	list := input.Get(path)
+	if !list.IsArray() {
+		return nil, fmt.Errorf("%v is not a list of values: %v", path, list.String())
+	}
+
+	values := []*big.Float{}
+	for _, v := range list.Array() {
+		if v.Type == gjson.Null {
+			continue
+		}
+		f, ok := new(big.Float).SetString(v.String())
+		if !ok {
+			return nil, fmt.Errorf("cannot parse into big.Float: %v", v.String())
+		}
+		values = append(values, f)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+
+	if a.MaxDeviation > 0 && len(values) > 0 {
+		values = withinDeviation(values, a.MaxDeviation)
+	}
+	if len(values) == 0 || len(values) < a.MinResponses {
+		return nil, fmt.Errorf("received %v usable responses, at least %v required", len(values), a.MinResponses)
+	}
+	return values, nil
+}
+
+func withinDeviation(sorted []*big.Float, maxDeviation float64) []*big.Float {
+	mid := median(sorted)
+	limit := new(big.Float).Abs(mid)
+	limit.Mul(limit, big.NewFloat(maxDeviation/100))
+
+	kept := []*big.Float{}
+	for _, v := range sorted {
+		diff := new(big.Float).Sub(v, mid)
+		if diff.Abs(diff).Cmp(limit) <= 0 {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+func median(sorted []*big.Float) *big.Float {
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return mean(sorted[mid-1 : mid+1])
+}
+
+func mean(values []*big.Float) *big.Float {
+	sum := new(big.Float)
+	for _, v := range values {
+		sum.Add(sum, v)
+	}
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(values))))
+}
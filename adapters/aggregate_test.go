@@ -0,0 +1,67 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/adapters"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregate_Perform(t *testing.T) {
+	tests := []struct {
+		name    string
+		adapter string
+		params  string
+		json    string
+		want    string
+		errored bool
+	}{
+		{"median odd", "median", `{}`, `{"results":["100","102","101.5"]}`, "101.5", false},
+		{"median even", "median", `{}`, `{"results":[100,101,103,102]}`, "101.5", false},
+		{"median custom path", "median", `{"path":"prices"}`, `{"prices":[1,2,3]}`, "2", false},
+		{"median skips nulls", "median", `{"minResponses":2}`, `{"results":[1,null,3]}`, "2", false},
+		{"median too few responses", "median", `{"minResponses":3}`, `{"results":[1,null,3]}`, "", true},
+		{"median not a list", "median", `{}`, `{"results":"1"}`, "", true},
+		{"median unparseable", "median", `{}`, `{"results":["1","one"]}`, "", true},
+		{"mean", "mean", `{}`, `{"results":["100","102","101"]}`, "101", false},
+		{"mean discards deviations", "mean", `{"maxDeviation":5}`, `{"results":[100,102,101,200]}`, "101", false},
+		{"mean too few within deviation", "mean", `{"maxDeviation":5,"minResponses":4}`, `{"results":[100,102,101,200]}`, "", true},
+		{"trimmed mean", "trimmedmean", `{"trim":20}`, `{"results":[1,100,101,102,500]}`, "101", false},
+		{"trimmed mean without trim", "trimmedmean", `{}`, `{"results":[1,2,3,6]}`, "3", false},
+		{"trimmed mean invalid trim", "trimmedmean", `{"trim":50}`, `{"results":[1,2,3]}`, "", true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			input := models.RunResult{
+				Data: cltest.JSONFromString(test.json),
+			}
+
+			var adapter adapters.Adapter
+			switch test.adapter {
+			case "median":
+				adapter = &adapters.Median{}
+			case "mean":
+				adapter = &adapters.Mean{}
+			case "trimmedmean":
+				adapter = &adapters.TrimmedMean{}
+			}
+			assert.NoError(t, json.Unmarshal([]byte(test.params), adapter))
+			result := adapter.Perform(input, nil)
+
+			if test.errored {
+				assert.Error(t, result.GetError())
+			} else {
+				assert.NoError(t, result.GetError())
+				val, err := result.Value()
+				assert.NoError(t, err)
+				assert.Equal(t, test.want, val)
+			}
+		})
+	}
+}
@@ -13,8 +13,13 @@ import (
 
 // Bridge adapter is responsible for connecting the task pipeline to external
 // adapters, allowing for custom computations to be executed and included in runs.
+//
+// The TaskRunID is sent along with the run's ID, so that an external adapter
+// replying later identifies the task it replies for, as a run can wait on
+// several bridges at once.
 type Bridge struct {
 	models.BridgeType
+	TaskRunID string
 }
 
 // Perform sends a POST request containing the JSON of the input RunResult to
@@ -45,9 +50,10 @@ func resumeBridge(input models.RunResult) models.RunResult {
 }
 
 func (ba *Bridge) handleNewRun(input models.RunResult) models.RunResult {
-	b, err := postToExternalAdapter(ba.URL.String(), input)
+	b, statusCode, err := postToExternalAdapter(ba.URL.String(), input, ba.TaskRunID)
 	if err != nil {
-		return baRunResultError(input, "post to external adapter", err)
+		err = fmt.Errorf("ExternalBridge post to external adapter: %v", err)
+		return input.WithRetryableError(err, statusCode)
 	}
 
 	var brr models.BridgeRunResult
@@ -64,25 +70,26 @@ func (ba *Bridge) handleNewRun(input models.RunResult) models.RunResult {
 	return rr
 }
 
-func postToExternalAdapter(url string, input models.RunResult) ([]byte, error) {
-	in, err := json.Marshal(&bridgeOutgoing{input})
+func postToExternalAdapter(url string, input models.RunResult, taskRunID string) ([]byte, int, error) {
+	in, err := json.Marshal(&bridgeOutgoing{input, taskRunID})
 	if err != nil {
-		return nil, fmt.Errorf("marshaling request body: %v", err)
+		return nil, 0, fmt.Errorf("marshaling request body: %v", err)
 	}
 
 	resp, err := http.Post(url, "application/json", bytes.NewBuffer(in))
 	if err != nil {
-		return nil, fmt.Errorf("POST request: %v", err)
+		return nil, 0, fmt.Errorf("POST request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
 		b, _ := ioutil.ReadAll(resp.Body)
 		err = fmt.Errorf("%v %v", resp.StatusCode, string(b))
-		return nil, fmt.Errorf("POST response: %v", err)
+		return nil, resp.StatusCode, fmt.Errorf("POST response: %v", err)
 	}
 
-	return ioutil.ReadAll(resp.Body)
+	b, err := ioutil.ReadAll(resp.Body)
+	return b, resp.StatusCode, err
 }
 
 func baRunResultError(in models.RunResult, str string, err error) models.RunResult {
@@ -91,15 +98,18 @@ func baRunResultError(in models.RunResult, str string, err error) models.RunResu
 
 type bridgeOutgoing struct {
 	models.RunResult
+	taskRunID string
 }
 
 func (bp bridgeOutgoing) MarshalJSON() ([]byte, error) {
 	anon := struct {
-		JobRunID string      `json:"id"`
-		Data     models.JSON `json:"data"`
+		JobRunID  string      `json:"id"`
+		TaskRunID string      `json:"taskRunId,omitempty"`
+		Data      models.JSON `json:"data"`
 	}{
-		JobRunID: bp.JobRunID,
-		Data:     bp.Data,
+		JobRunID:  bp.JobRunID,
+		TaskRunID: bp.taskRunID,
+		Data:      bp.Data,
 	}
 	return json.Marshal(anon)
 }
@@ -10,6 +10,25 @@
 // Sends a POST request to the specified URL and will return the response.
 //  { "type": "HTTPPost", "url": "https://weiwatchers.com/api" }
 //
+// HTTPPut, HTTPPatch and HTTPDelete
+//
+// Send PUT, PATCH and DELETE requests in the same way.
+//  { "type": "HTTPDelete", "url": "https://some-api-example.net/api/1" }
+//
+// The HTTP adapters accept "headers" and "queryParams" objects, a "timeout"
+// such as "10s" and a "maxResponseSize" in bytes. HTTPPost, HTTPPut and
+// HTTPPatch send the input's data as the request body, unless a "body"
+// template is given, which is filled in with the input's data. Values placed
+// in a JSON body go through the template's json function to be quoted and
+// escaped.
+//  {
+//    "type": "HTTPPost",
+//    "url": "https://some-api-example.net/api",
+//    "headers": { "X-API-Key": "secret" },
+//    "body": "{\"symbol\":{{json .symbol}}}",
+//    "timeout": "10s"
+//  }
+//
 // JSONParse
 //
 // The JSONParse adapter will obtain the value(s) for the given field(s).
@@ -42,18 +61,55 @@
 //     "functionSelector": "0xffffffff"
 //   }
 //
+// Given a Solidity function signature, it instead ABI encodes the values at
+// the argument paths in the run's data. Supported types are uint256, int256,
+// bytes32, address, bool, string and bytes.
+//   {
+//     "type": "EthTx",
+//     "address": "0x0000000000000000000000000000000000000000",
+//     "functionSignature": "fulfill(bytes32,uint256,string)",
+//     "arguments": ["requestId", "price", "symbol"]
+//   }
+//
+// The transaction's sending "from" address, "gasLimit", "value" in Wei and
+// "gasPrice" can also be set. Without "from", transactions are spread across
+// the node's accounts. The gas price strategy is "fixed" (with an optional
+// "price"), "network" for the node's eth_gasPrice, or "percentile" of the
+// prices paid in the last "blocks". It never exceeds ETH_GAS_PRICE_MAX.
+//   {
+//     "type": "EthTx",
+//     "address": "0x0000000000000000000000000000000000000000",
+//     "functionSelector": "0xffffffff",
+//     "gasLimit": 200000,
+//     "value": "1000000000000000",
+//     "gasPrice": { "strategy": "percentile", "percentile": 60, "blocks": 20 }
+//   }
+//
 // Multiplier
 //
 // The Multiplier adapter multiplies the given input value times another specified
 // value.
 //   { "type": "Multiply", "times": 100 }
 //
+// Median, Mean and TrimmedMean
+//
+// The Median, Mean and TrimmedMean adapters reduce the list of values at
+// "path" (by default the "results" a join task receives) to a single value.
+// Values deviating from the median by more than "maxDeviation" percent are
+// discarded, and the run errors when fewer than "minResponses" remain.
+// TrimmedMean drops "trim" percent of the values from each end.
+//   { "type": "TrimmedMean", "minResponses": 3, "maxDeviation": 5, "trim": 20 }
+//
 // Bridge
 //
 // The Bridge adapter is used to send and receive data to and from external adapters.
-// The adapter will POST to the target adapter URL with an "id" field for the TaskRunID
-// and a "data" field.
+// The adapter will POST to the target adapter URL with an "id" field for the JobRunID,
+// a "taskRunId" field and a "data" field.
 // For example:
-//  {"id":"b8004e2989e24e1d8e4449afad2eb480","data":{}}
+//  {"id":"b8004e2989e24e1d8e4449afad2eb480","taskRunId":"9e5c1a02c3f64f2b8d3f7a7c5e3b1d20","data":{}}
+//
+// An adapter replying later with a PATCH to /v2/runs/:id includes the
+// "taskRunId" it was given, which is required while a run waits on several
+// bridges.
 //
 package adapters
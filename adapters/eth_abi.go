@@ -0,0 +1,205 @@
+package adapters
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/tidwall/gjson"
+)
+
+// abiWordLength is the number of bytes in an ABI encoded word.
+const abiWordLength = 32
+
+var (
+	maxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	minInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+)
+
+var abiTypeAliases = map[string]string{
+	"uint": "uint256",
+	"int":  "int256",
+}
+
+var abiTypes = map[string]bool{
+	"uint256": true,
+	"int256":  true,
+	"bytes32": true,
+	"address": true,
+	"bool":    true,
+	"string":  true,
+	"bytes":   true,
+}
+
+// parseFunctionSignature returns the canonical form of a Solidity function
+// signature, such as "fulfill(bytes32,uint256)", along with its argument
+// types.
+func parseFunctionSignature(signature string) (string, []string, error) {
+	signature = strings.Replace(signature, " ", "", -1)
+	open := strings.Index(signature, "(")
+	if open < 1 || !strings.HasSuffix(signature, ")") {
+		return "", nil, fmt.Errorf("invalid function signature %v", signature)
+	}
+
+	name := signature[:open]
+	args := signature[open+1 : len(signature)-1]
+	types := []string{}
+	if args != "" {
+		types = strings.Split(args, ",")
+	}
+	for i, t := range types {
+		if alias, ok := abiTypeAliases[t]; ok {
+			types[i] = alias
+		}
+		if !abiTypes[types[i]] {
+			return "", nil, fmt.Errorf("unsupported argument type %v", t)
+		}
+	}
+	return fmt.Sprintf("%v(%v)", name, strings.Join(types, ",")), types, nil
+}
+
+// functionSelectorFor returns the FunctionSelector of a canonical function
+// signature.
+func functionSelectorFor(signature string) models.FunctionSelector {
+	return models.BytesToFunctionSelector(crypto.Keccak256([]byte(signature)))
+}
+
+// abiEncode encodes the values as the arguments of the given types, placing
+// dynamic values after the static head.
+func abiEncode(types []string, values []gjson.Result) ([]byte, error) {
+	if len(types) != len(values) {
+		return nil, fmt.Errorf("expected %v arguments, got %v", len(types), len(values))
+	}
+
+	head := []byte{}
+	tail := []byte{}
+	for i, t := range types {
+		if t == "string" || t == "bytes" {
+			b, err := abiDynamicBytes(t, values[i])
+			if err != nil {
+				return nil, fmt.Errorf("argument %v: %v", i, err)
+			}
+			offset := big.NewInt(int64(len(types)*abiWordLength + len(tail)))
+			head = append(head, common.LeftPadBytes(offset.Bytes(), abiWordLength)...)
+			tail = append(tail, abiEncodeDynamic(b)...)
+			continue
+		}
+
+		word, err := abiEncodeStatic(t, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("argument %v: %v", i, err)
+		}
+		head = append(head, word...)
+	}
+	return append(head, tail...), nil
+}
+
+func abiEncodeStatic(t string, value gjson.Result) ([]byte, error) {
+	switch t {
+	case "uint256":
+		n, err := abiParseInt(value)
+		if err != nil {
+			return nil, err
+		}
+		if n.Sign() < 0 || n.BitLen() > 256 {
+			return nil, fmt.Errorf("%v out of range for uint256", value.String())
+		}
+		return utils.HexToBytes(utils.EVMHexNumber(n))
+	case "int256":
+		n, err := abiParseInt(value)
+		if err != nil {
+			return nil, err
+		}
+		if n.Cmp(maxInt256) > 0 || n.Cmp(minInt256) < 0 {
+			return nil, fmt.Errorf("%v out of range for int256", value.String())
+		}
+		hex, err := utils.EVMSignedHexNumber(new(big.Int).Set(n))
+		if err != nil {
+			return nil, err
+		}
+		return utils.HexToBytes(hex)
+	case "bytes32":
+		b, err := abiParseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > abiWordLength {
+			return nil, fmt.Errorf("%v is longer than 32 bytes", value.String())
+		}
+		return common.RightPadBytes(b, abiWordLength), nil
+	case "address":
+		if !common.IsHexAddress(value.String()) {
+			return nil, fmt.Errorf("%v is not an address", value.String())
+		}
+		return common.LeftPadBytes(common.HexToAddress(value.String()).Bytes(), abiWordLength), nil
+	case "bool":
+		if value.Type != gjson.True && value.Type != gjson.False &&
+			value.String() != "true" && value.String() != "false" {
+			return nil, fmt.Errorf("%v is not a bool", value.String())
+		}
+		word := make([]byte, abiWordLength)
+		if value.Bool() {
+			word[abiWordLength-1] = 1
+		}
+		return word, nil
+	}
+	return nil, fmt.Errorf("unsupported argument type %v", t)
+}
+
+func abiDynamicBytes(t string, value gjson.Result) ([]byte, error) {
+	if !value.Exists() {
+		return nil, errors.New("missing value")
+	}
+	if t == "string" {
+		return []byte(value.String()), nil
+	}
+	if !utils.HasHexPrefix(value.String()) {
+		return nil, fmt.Errorf("%v is not 0x prefixed hex", value.String())
+	}
+	return hexutil.Decode(value.String())
+}
+
+func abiEncodeDynamic(b []byte) []byte {
+	length := common.LeftPadBytes(big.NewInt(int64(len(b))).Bytes(), abiWordLength)
+	padded := len(b)
+	if remainder := padded % abiWordLength; remainder != 0 {
+		padded += abiWordLength - remainder
+	}
+	return append(length, common.RightPadBytes(b, padded)...)
+}
+
+func abiParseInt(value gjson.Result) (*big.Int, error) {
+	str := value.String()
+	if value.Type == gjson.Number {
+		str = value.Raw
+	}
+
+	n, ok := new(big.Int), false
+	if utils.HasHexPrefix(str) {
+		n, ok = n.SetString(str[2:], 16)
+	} else {
+		n, ok = n.SetString(str, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("cannot parse %v as an integer", value.String())
+	}
+	return n, nil
+}
+
+// abiParseBytes returns the bytes of a 0x prefixed hex value, or of the
+// value's text otherwise.
+func abiParseBytes(value gjson.Result) ([]byte, error) {
+	if !value.Exists() {
+		return nil, errors.New("missing value")
+	}
+	if utils.HasHexPrefix(value.String()) {
+		return hexutil.Decode(value.String())
+	}
+	return []byte(value.String()), nil
+}
@@ -1,19 +1,39 @@
 package adapters
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/tidwall/gjson"
 )
 
 // EthTx holds the Address to send the result to and the FunctionSelector
 // to execute.
+//
+// Alternatively, a Solidity FunctionSignature such as
+// "fulfill(bytes32,uint256,string)" can be given with the Arguments, a list
+// of paths to the values in the run's data. The values are then ABI encoded
+// as the signature's types, and the FunctionSelector and DataPrefix are
+// ignored.
+//
+// The From address sending the transaction, its GasLimit, the Value in Wei
+// sent along and the GasPrice strategy are optional, defaulting to those of
+// the TxManager.
 type EthTx struct {
-	Address          common.Address          `json:"address"`
-	FunctionSelector models.FunctionSelector `json:"functionSelector"`
-	DataPrefix       hexutil.Bytes           `json:"dataPrefix"`
+	Address           common.Address          `json:"address"`
+	FunctionSelector  models.FunctionSelector `json:"functionSelector"`
+	DataPrefix        hexutil.Bytes           `json:"dataPrefix"`
+	FunctionSignature string                  `json:"functionSignature"`
+	Arguments         []string                `json:"arguments"`
+	From              common.Address          `json:"from"`
+	GasLimit          uint64                  `json:"gasLimit"`
+	Value             *assets.Eth             `json:"value"`
+	GasPrice          store.GasPriceStrategy  `json:"gasPrice"`
 }
 
 // Perform creates the run result for the transaction if the existing run result
@@ -31,17 +51,12 @@ func createTxRunResult(
 	input models.RunResult,
 	store *store.Store,
 ) models.RunResult {
-	val, err := input.Value()
-	if err != nil {
-		return input.WithError(err)
-	}
-
-	data, err := utils.HexToBytes(e.FunctionSelector.String(), e.DataPrefix.String(), val)
+	data, err := e.callData(input)
 	if err != nil {
 		return input.WithError(err)
 	}
 
-	tx, err := store.TxManager.CreateTx(e.Address, data)
+	tx, err := store.TxManager.CreateTxWithOptions(e.Address, data, e.txOptions())
 	if err != nil {
 		return input.WithError(err)
 	}
@@ -50,6 +65,45 @@ func createTxRunResult(
 	return ensureTxRunResult(sendResult, store)
 }
 
+func (etx *EthTx) txOptions() store.TxOptions {
+	opts := store.TxOptions{
+		From:     etx.From,
+		GasLimit: etx.GasLimit,
+		GasPrice: etx.GasPrice,
+	}
+	if etx.Value != nil {
+		opts.Value = (*big.Int)(etx.Value)
+	}
+	return opts
+}
+
+// callData returns the data of the transaction calling the contract's
+// function with the input.
+func (etx *EthTx) callData(input models.RunResult) ([]byte, error) {
+	if etx.FunctionSignature == "" {
+		val, err := input.Value()
+		if err != nil {
+			return nil, err
+		}
+		return utils.HexToBytes(etx.FunctionSelector.String(), etx.DataPrefix.String(), val)
+	}
+
+	signature, types, err := parseFunctionSignature(etx.FunctionSignature)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]gjson.Result, len(etx.Arguments))
+	for i, path := range etx.Arguments {
+		values[i] = input.Get(path)
+	}
+	args, err := abiEncode(types, values)
+	if err != nil {
+		return nil, err
+	}
+	selector := functionSelectorFor(signature)
+	return append(selector[:], args...), nil
+}
+
 func ensureTxRunResult(input models.RunResult, store *store.Store) models.RunResult {
 	val, err := input.Value()
 	if err != nil {
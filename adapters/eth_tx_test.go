@@ -1,10 +1,12 @@
 package adapters_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	strpkg "github.com/smartcontractkit/chainlink/store"
@@ -69,6 +71,146 @@ func TestEthTxAdapter_Perform_Confirmed(t *testing.T) {
 	ethMock.EventuallyAllCalled(t)
 }
 
+func TestEthTxAdapter_Perform_FunctionSignature(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+
+	address := cltest.NewAddress()
+	signature := "fulfill(bytes32, int, string, address, bool, bytes, uint256)"
+	selector := crypto.Keccak256([]byte("fulfill(bytes32,int256,string,address,bool,bytes,uint256)"))[:4]
+	wantData := utils.HexConcat(
+		hexutil.Encode(selector),
+		"1111111111111111111111111111111111111111111111111111111111111111",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"00000000000000000000000000000000000000000000000000000000000000e0",
+		"0000000000000000000000000000000000000000000000000000000000000abc",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000120",
+		"00000000000000000000000000000000000000000000000000000000000003e8",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"6869000000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0102000000000000000000000000000000000000000000000000000000000000",
+	)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", `0x0100`)
+	assert.Nil(t, app.Start())
+
+	hash := cltest.NewHash()
+	ethMock.Register("eth_sendRawTransaction", hash,
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			tx, err := utils.DecodeEthereumTx(rlp)
+			assert.NoError(t, err)
+			assert.Equal(t, wantData, hexutil.Encode(tx.Data()))
+			return nil
+		})
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+	ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{})
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+
+	adapter := adapters.EthTx{
+		Address:           address,
+		FunctionSignature: signature,
+		Arguments:         []string{"id", "delta", "name", "addr", "ok", "blob", "amount"},
+	}
+	input := models.RunResult{Data: cltest.JSONFromString(`{
+		"id": "0x1111111111111111111111111111111111111111111111111111111111111111",
+		"delta": -1,
+		"name": "hi",
+		"addr": "0x0000000000000000000000000000000000000abc",
+		"ok": true,
+		"blob": "0x0102",
+		"amount": "1000"
+	}`)}
+	result := adapter.Perform(input, store)
+
+	assert.False(t, result.HasError())
+	assert.Equal(t, models.RunStatusPendingConfirmations, result.Status)
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestEthTxAdapter_Perform_FunctionSignatureErrors(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	tests := []struct {
+		name      string
+		signature string
+		arguments []string
+		data      string
+	}{
+		{"invalid signature", "fulfill", []string{}, `{}`},
+		{"unsupported type", "fulfill(uint8)", []string{"a"}, `{"a":1}`},
+		{"missing arguments", "fulfill(uint256,bool)", []string{"a"}, `{"a":1}`},
+		{"uint256 out of range", "fulfill(uint256)", []string{"a"}, `{"a":-1}`},
+		{"bytes32 too long", "fulfill(bytes32)", []string{"a"}, `{"a":"this string is much longer than thirty two bytes"}`},
+		{"invalid address", "fulfill(address)", []string{"a"}, `{"a":"0x1234"}`},
+		{"invalid bool", "fulfill(bool)", []string{"a"}, `{"a":"yes"}`},
+		{"bytes without hex", "fulfill(bytes)", []string{"a"}, `{"a":"abc"}`},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			adapter := adapters.EthTx{
+				FunctionSignature: test.signature,
+				Arguments:         test.arguments,
+			}
+			input := models.RunResult{Data: cltest.JSONFromString(test.data)}
+			result := adapter.Perform(input, store)
+			assert.True(t, result.HasError())
+		})
+	}
+}
+
+func TestEthTxAdapter_Perform_TxOptions(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", `0x0100`)
+	assert.Nil(t, app.Start())
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash(),
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			tx, err := utils.DecodeEthereumTx(rlp)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(200000), tx.Gas())
+			assert.Equal(t, big.NewInt(1000), tx.Value())
+			assert.Equal(t, big.NewInt(30000000000), tx.GasPrice())
+			return nil
+		})
+	ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{})
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+
+	adapter := adapters.EthTx{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"address": "0x0000000000000000000000000000000000000abc",
+		"functionSelector": "0xffffffff",
+		"gasLimit": 200000,
+		"value": "1000",
+		"gasPrice": {"strategy": "fixed", "price": "30000000000"}
+	}`), &adapter))
+	input := cltest.RunResultWithValue("0x01")
+	result := adapter.Perform(input, store)
+
+	assert.False(t, result.HasError())
+	assert.Equal(t, models.RunStatusPendingConfirmations, result.Status)
+	ethMock.EventuallyAllCalled(t)
+}
+
 func TestEthTxAdapter_Perform_FromPendingConfirmations_StillPending(t *testing.T) {
 	t.Parallel()
 
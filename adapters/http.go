@@ -4,67 +4,177 @@ package adapters
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"text/template"
 
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 )
 
+// HTTPRequestOptions holds the optional settings shared by the HTTP adapters:
+// the headers to send, query parameters to add to the URL, a timeout for the
+// whole request and the maximum number of bytes accepted in the response.
+type HTTPRequestOptions struct {
+	Headers         map[string]string `json:"headers"`
+	QueryParams     map[string]string `json:"queryParams"`
+	Timeout         models.Duration   `json:"timeout"`
+	MaxResponseSize int64             `json:"maxResponseSize"`
+}
+
 // HTTPGet requires a URL which is used for a GET request when the adapter is called.
 type HTTPGet struct {
 	URL models.WebURL `json:"url"`
+	HTTPRequestOptions
 }
 
 // Perform ensures that the adapter's URL responds to a GET request without
 // errors and returns the response body as the "value" field of the result.
 func (hga *HTTPGet) Perform(input models.RunResult, _ *store.Store) models.RunResult {
-	response, err := http.Get(hga.URL.String())
-	if err != nil {
-		return input.WithError(err)
-	}
-
-	defer response.Body.Close()
-
-	bytes, err := ioutil.ReadAll(response.Body)
-	body := string(bytes)
-	if err != nil {
-		return input.WithError(err)
-	}
+	return sendRequest(input, http.MethodGet, hga.URL, nil, hga.HTTPRequestOptions)
+}
 
-	if response.StatusCode >= 400 {
-		return input.WithError(fmt.Errorf(body))
-	}
+// HTTPDelete requires a URL which is used for a DELETE request when the adapter is called.
+type HTTPDelete struct {
+	URL models.WebURL `json:"url"`
+	HTTPRequestOptions
+}
 
-	return input.WithValue(body)
+// Perform ensures that the adapter's URL responds to a DELETE request without
+// errors and returns the response body as the "value" field of the result.
+func (hda *HTTPDelete) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	return sendRequest(input, http.MethodDelete, hda.URL, nil, hda.HTTPRequestOptions)
 }
 
 // HTTPPost requires a URL which is used for a POST request when the adapter is called.
+// The request body is the input's data, unless a Body template is given, in
+// which case the template is executed with the input's data. Values placed in
+// a JSON body should go through the template's json function, as in
+// {"symbol":{{json .symbol}}}, so that they are quoted and escaped.
 type HTTPPost struct {
-	URL models.WebURL `json:"url"`
+	URL  models.WebURL `json:"url"`
+	Body string        `json:"body"`
+	HTTPRequestOptions
 }
 
 // Perform ensures that the adapter's URL responds to a POST request without
 // errors and returns the response body as the "value" field of the result.
 func (hpa *HTTPPost) Perform(input models.RunResult, _ *store.Store) models.RunResult {
-	reqBody := bytes.NewBufferString(input.Data.String())
-	response, err := http.Post(hpa.URL.String(), "application/json", reqBody)
+	return sendRequestWithBody(input, http.MethodPost, hpa.URL, hpa.Body, hpa.HTTPRequestOptions)
+}
+
+// HTTPPut requires a URL which is used for a PUT request when the adapter is called.
+// The request body is built as for HTTPPost.
+type HTTPPut struct {
+	URL  models.WebURL `json:"url"`
+	Body string        `json:"body"`
+	HTTPRequestOptions
+}
+
+// Perform ensures that the adapter's URL responds to a PUT request without
+// errors and returns the response body as the "value" field of the result.
+func (hpa *HTTPPut) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	return sendRequestWithBody(input, http.MethodPut, hpa.URL, hpa.Body, hpa.HTTPRequestOptions)
+}
+
+// HTTPPatch requires a URL which is used for a PATCH request when the adapter is called.
+// The request body is built as for HTTPPost.
+type HTTPPatch struct {
+	URL  models.WebURL `json:"url"`
+	Body string        `json:"body"`
+	HTTPRequestOptions
+}
+
+// Perform ensures that the adapter's URL responds to a PATCH request without
+// errors and returns the response body as the "value" field of the result.
+func (hpa *HTTPPatch) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	return sendRequestWithBody(input, http.MethodPatch, hpa.URL, hpa.Body, hpa.HTTPRequestOptions)
+}
+
+func sendRequestWithBody(
+	input models.RunResult,
+	method string,
+	url models.WebURL,
+	bodyTemplate string,
+	opts HTTPRequestOptions,
+) models.RunResult {
+	if bodyTemplate == "" {
+		return sendRequest(input, method, url, bytes.NewBufferString(input.Data.String()), opts)
+	}
+
+	tmpl, err := template.New("body").
+		Option("missingkey=error").
+		Funcs(bodyTemplateFuncs).
+		Parse(bodyTemplate)
+	if err != nil {
+		return input.WithError(fmt.Errorf("parsing body template: %v", err))
+	}
+	body := &bytes.Buffer{}
+	if err := tmpl.Execute(body, input.Data.Value()); err != nil {
+		return input.WithError(fmt.Errorf("executing body template: %v", err))
+	}
+	return sendRequest(input, method, url, body, opts)
+}
+
+var bodyTemplateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+func sendRequest(
+	input models.RunResult,
+	method string,
+	url models.WebURL,
+	body io.Reader,
+	opts HTTPRequestOptions,
+) models.RunResult {
+	request, err := http.NewRequest(method, url.String(), body)
 	if err != nil {
 		return input.WithError(err)
 	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range opts.Headers {
+		request.Header.Set(key, value)
+	}
+	if len(opts.QueryParams) > 0 {
+		query := request.URL.Query()
+		for key, value := range opts.QueryParams {
+			query.Set(key, value)
+		}
+		request.URL.RawQuery = query.Encode()
+	}
+
+	client := &http.Client{Timeout: opts.Timeout.Duration}
+	response, err := client.Do(request)
+	if err != nil {
+		return input.WithRetryableError(err, 0)
+	}
 
 	defer response.Body.Close()
 
-	bytes, err := ioutil.ReadAll(response.Body)
-	body := string(bytes)
+	reader := io.Reader(response.Body)
+	if opts.MaxResponseSize > 0 {
+		reader = io.LimitReader(response.Body, opts.MaxResponseSize+1)
+	}
+	b, err := ioutil.ReadAll(reader)
+	responseBody := string(b)
 	if err != nil {
-		return input.WithError(err)
+		return input.WithRetryableError(err, 0)
+	}
+	if opts.MaxResponseSize > 0 && int64(len(b)) > opts.MaxResponseSize {
+		return input.WithError(fmt.Errorf("response larger than the maximum of %v bytes", opts.MaxResponseSize))
 	}
 
 	if response.StatusCode >= 400 {
-		return input.WithError(fmt.Errorf(body))
+		return input.WithRetryableError(fmt.Errorf(responseBody), response.StatusCode)
 	}
 
-	return input.WithValue(body)
+	return input.WithValue(responseBody)
 }
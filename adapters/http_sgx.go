@@ -9,20 +9,42 @@ package adapters
 import "C"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 )
 
+// HTTPRequestOptions holds the optional settings of the HTTP adapters. None
+// of them are supported within the enclave, where a request using them
+// errors rather than being sent without them.
+type HTTPRequestOptions struct {
+	Headers         map[string]string `json:"headers"`
+	QueryParams     map[string]string `json:"queryParams"`
+	Timeout         models.Duration   `json:"timeout"`
+	MaxResponseSize int64             `json:"maxResponseSize"`
+}
+
+func (opts HTTPRequestOptions) check() error {
+	if len(opts.Headers) > 0 || len(opts.QueryParams) > 0 || opts.Timeout.Duration != 0 || opts.MaxResponseSize != 0 {
+		return errors.New("headers, queryParams, timeout and maxResponseSize are not supported within the enclave")
+	}
+	return nil
+}
+
 // HTTPGet requires a URL which is used for a GET request when the adapter is called.
 type HTTPGet struct {
 	URL models.WebURL `json:"url"`
+	HTTPRequestOptions
 }
 
 // Perform ensures that the adapter's URL responds to a GET request without
 // errors and returns the response body as the "value" field of the result.
 func (hga *HTTPGet) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	if err := hga.check(); err != nil {
+		return input.WithError(err)
+	}
 	body, err := C.http_get(C.CString(hga.URL.String()))
 	if err != nil {
 		return input.WithError(fmt.Errorf(C.GoString(body)))
@@ -31,16 +53,50 @@ func (hga *HTTPGet) Perform(input models.RunResult, _ *store.Store) models.RunRe
 }
 
 // HTTPPost requires a URL which is used for a POST request when the adapter is called.
+// The request body is always the input's data, as body templates are not
+// supported within the enclave.
 type HTTPPost struct {
-	URL models.WebURL `json:"url"`
+	URL  models.WebURL `json:"url"`
+	Body string        `json:"body"`
+	HTTPRequestOptions
 }
 
 // Perform ensures that the adapter's URL responds to a POST request without
 // errors and returns the response body as the "value" field of the result.
 func (hpa *HTTPPost) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	if hpa.Body != "" {
+		return input.WithError(errors.New("body templates are not supported within the enclave"))
+	}
+	if err := hpa.check(); err != nil {
+		return input.WithError(err)
+	}
 	body, err := C.http_post(C.CString(hpa.URL.String()), C.CString(input.Data.String()))
 	if err != nil {
 		return input.WithError(fmt.Errorf(C.GoString(body)))
 	}
 	return input.WithValue(C.GoString(body))
 }
+
+// HTTPPut is not supported within the enclave.
+type HTTPPut struct{}
+
+// Perform returns an error as PUT requests are not supported within the enclave.
+func (*HTTPPut) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	return input.WithError(fmt.Errorf("HTTPPut is not supported within the enclave"))
+}
+
+// HTTPPatch is not supported within the enclave.
+type HTTPPatch struct{}
+
+// Perform returns an error as PATCH requests are not supported within the enclave.
+func (*HTTPPatch) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	return input.WithError(fmt.Errorf("HTTPPatch is not supported within the enclave"))
+}
+
+// HTTPDelete is not supported within the enclave.
+type HTTPDelete struct{}
+
+// Perform returns an error as DELETE requests are not supported within the enclave.
+func (*HTTPDelete) Perform(input models.RunResult, _ *store.Store) models.RunResult {
+	return input.WithError(fmt.Errorf("HTTPDelete is not supported within the enclave"))
+}
@@ -1,7 +1,12 @@
 package adapters_test
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
@@ -42,6 +47,7 @@ func TestHttpGet_Perform(t *testing.T) {
 		{"success with HTML", 200, `<html>results!</html>`, false, `<html>results!</html>`},
 		{"not found", 400, "inputValue", true, `<html>so bad</html>`},
 		{"server error", 400, "inputValue", true, `Invalid request`},
+		{"bad gateway", 502, "inputValue", true, `Bad Gateway`},
 	}
 
 	for _, tt := range cases {
@@ -60,6 +66,7 @@ func TestHttpGet_Perform(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, test.want, val)
 			assert.Equal(t, test.wantErrored, result.HasError())
+			assert.Equal(t, test.wantErrored, result.Retryable)
 			assert.Equal(t, false, result.Status.PendingBridge())
 		})
 	}
@@ -101,3 +108,119 @@ func TestHttpPost_Perform(t *testing.T) {
 		})
 	}
 }
+
+func TestHttpAdapters_RequestOptions(t *testing.T) {
+	tests := []struct {
+		name       string
+		adapter    string
+		params     string
+		wantMethod string
+		wantBody   string
+	}{
+		{"get", "httpget", `{}`, "GET", ``},
+		{"delete", "httpdelete", `{}`, "DELETE", ``},
+		{"post", "httppost", `{}`, "POST", `{"symbol":"ETH"}`},
+		{"put with template", "httpput", `{"body":"sym={{.symbol}}"}`, "PUT", `sym=ETH`},
+		{"patch with template", "httppatch", `{"body":"{{.symbol}}"}`, "PATCH", `ETH`},
+		{"post with json template", "httppost", `{"body":"{\\"sym\\":{{json .symbol}}}"}`, "POST", `{"sym":"ETH"}`},
+	}
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, test.wantMethod, r.Method)
+				assert.Equal(t, test.wantBody, string(b))
+				assert.Equal(t, "secret", r.Header.Get("X-API-Key"))
+				assert.Equal(t, "1", r.URL.Query().Get("page"))
+				assert.Equal(t, "usd", r.URL.Query().Get("currency"))
+				w.Write([]byte("ok"))
+			}))
+			defer server.Close()
+
+			params := cltest.JSONFromString(test.params)
+			params, err := params.Merge(cltest.JSONFromString(`{
+				"url": "%v?page=1",
+				"headers": {"X-API-Key": "secret"},
+				"queryParams": {"currency": "usd"}
+			}`, server.URL))
+			assert.NoError(t, err)
+
+			adapter, err := adapters.For(cltest.NewTask(test.adapter, params.String()), store)
+			assert.NoError(t, err)
+			input := models.RunResult{Data: cltest.JSONFromString(`{"symbol":"ETH"}`)}
+			result := adapter.Perform(input, nil)
+
+			assert.NoError(t, result.GetError())
+			assert.Equal(t, "ok", result.Get("value").String())
+		})
+	}
+}
+
+func TestHttpPost_Perform_JSONTemplateEscapesValues(t *testing.T) {
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		body = string(b)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	params := cltest.JSONFromString(`{"url":"%v","body":"{\\"symbol\\":{{json .symbol}}}"}`, server.URL)
+	adapter, err := adapters.For(cltest.NewTask("httppost", params.String()), store)
+	assert.NoError(t, err)
+	input := models.RunResult{Data: cltest.JSONFromString(`{"symbol":"ETH\\",\\"admin\\":true"}`)}
+	result := adapter.Perform(input, nil)
+
+	assert.NoError(t, result.GetError())
+	assert.Equal(t, `{"symbol":"ETH\\",\\"admin\\":true"}`, body)
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(body), &decoded))
+	assert.Equal(t, map[string]interface{}{"symbol": `ETH","admin":true`}, decoded)
+}
+
+func TestHttpGet_Perform_Limits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		w.Write([]byte("0123456789"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		path          string
+		options       adapters.HTTPRequestOptions
+		wantErrored   bool
+		wantRetryable bool
+	}{
+		{"within limits", "/", adapters.HTTPRequestOptions{MaxResponseSize: 10}, false, false},
+		{"response too large", "/", adapters.HTTPRequestOptions{MaxResponseSize: 9}, true, false},
+		{"timed out", "/slow", adapters.HTTPRequestOptions{Timeout: models.Duration{Duration: 10 * time.Millisecond}}, true, true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			hga := adapters.HTTPGet{
+				URL:                cltest.MustParseWebURL(server.URL + test.path),
+				HTTPRequestOptions: test.options,
+			}
+			result := hga.Perform(models.RunResult{}, nil)
+
+			assert.Equal(t, test.wantErrored, result.HasError())
+			assert.Equal(t, test.wantRetryable, result.Retryable)
+		})
+	}
+}
@@ -3,17 +3,20 @@ package adapters
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 
 	simplejson "github.com/bitly/go-simplejson"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/tidwall/gjson"
 )
 
 // JSONParse holds a path to the desired field in a JSON object,
-// made up of an array of strings.
+// made up of an array of strings, or a gjson Expression selecting it.
 type JSONParse struct {
-	Path []string `json:"path"`
+	Path       []string `json:"path"`
+	Expression string   `json:"expression"`
 }
 
 // Perform returns the value associated to the desired field for a
@@ -27,13 +30,27 @@ type JSONParse struct {
 //     ]
 //   }
 //
-// Then ["0","last"] would be the path, and "111" would be the returned value
+// Then ["data","0","last"] would be the path, and "1111" would be the
+// returned value. Negative array indexes count from the end, so
+// ["data","-1","last"] would return "2222".
+//
+// Alternatively, a gjson expression can filter arrays and use wildcards. An
+// expression selecting several values returns them as an array. For the
+// same data, data.#[last>"2000"].last would return "2222" and data.#.last
+// would return ["1111","2222"].
 func (jpa *JSONParse) Perform(input models.RunResult, _ *store.Store) models.RunResult {
 	val, err := input.Value()
 	if err != nil {
 		return input.WithError(err)
 	}
 
+	if jpa.Expression != "" {
+		return parseExpression(input, val, jpa.Expression)
+	}
+	if len(jpa.Path) == 0 {
+		return input.WithError(errors.New("JSONParse requires a path or an expression"))
+	}
+
 	js, err := simplejson.NewJson([]byte(val))
 	if err != nil {
 		return input.WithError(err)
@@ -44,7 +61,7 @@ func (jpa *JSONParse) Perform(input models.RunResult, _ *store.Store) models.Run
 		return input.WithError(err)
 	}
 
-	rval, ok := js.CheckGet(jpa.Path[len(jpa.Path)-1])
+	rval, ok := getPathElement(js, jpa.Path[len(jpa.Path)-1])
 	if !ok {
 		input.Data, err = input.Data.Add("value", nil)
 		if err != nil {
@@ -60,6 +77,32 @@ func (jpa *JSONParse) Perform(input models.RunResult, _ *store.Store) models.Run
 	return input.WithValue(result)
 }
 
+func parseExpression(input models.RunResult, val string, expression string) models.RunResult {
+	if !gjson.Valid(val) {
+		return input.WithError(fmt.Errorf("cannot parse value as JSON: %v", val))
+	}
+
+	result := gjson.Get(val, expression)
+	if !result.Exists() {
+		data, err := input.Data.Add("value", nil)
+		if err != nil {
+			return input.WithError(err)
+		}
+		input.Data = data
+		return input
+	}
+	if !result.IsArray() {
+		return input.WithValue(result.String())
+	}
+
+	data, err := input.Data.Add("value", result.Value())
+	if err != nil {
+		return input.WithError(err)
+	}
+	input.Data = data
+	return input
+}
+
 func getStringValue(js *simplejson.Json) (string, error) {
 	str, err := js.String()
 	if err != nil {
@@ -75,11 +118,7 @@ func getStringValue(js *simplejson.Json) (string, error) {
 func getEarlyPath(js *simplejson.Json, path []string) (*simplejson.Json, error) {
 	var ok bool
 	for _, k := range path[:len(path)-1] {
-		if isArray(js, k) {
-			js, ok = arrayGet(js, k)
-		} else {
-			js, ok = js.CheckGet(k)
-		}
+		js, ok = getPathElement(js, k)
 		if !ok {
 			return js, errors.New("No value could be found for the key '" + k + "'")
 		}
@@ -87,13 +126,26 @@ func getEarlyPath(js *simplejson.Json, path []string) (*simplejson.Json, error)
 	return js, nil
 }
 
+func getPathElement(js *simplejson.Json, key string) (*simplejson.Json, bool) {
+	if isArray(js, key) {
+		return arrayGet(js, key)
+	}
+	return js.CheckGet(key)
+}
+
 func arrayGet(js *simplejson.Json, key string) (*simplejson.Json, bool) {
-	i, err := strconv.ParseUint(key, 10, 64)
+	i, err := strconv.ParseInt(key, 10, 64)
 	if err != nil {
 		return js, false
 	}
 	a, err := js.Array()
-	if err != nil || len(a) < int(i-1) {
+	if err != nil {
+		return js, false
+	}
+	if i < 0 {
+		i += int64(len(a))
+	}
+	if i < 0 || i >= int64(len(a)) {
 		return js, false
 	}
 	return js.GetIndex(int(i)), true
@@ -27,6 +27,12 @@ func TestJsonParse_Perform(t *testing.T) {
 			`{"value":"0.99991"}`, false, false},
 		{"float value", `{"availability":0.99991}`, []string{"availability"},
 			`{"value":"0.99991"}`, false, false},
+		{"negative array index path", `{"data":[{"last":"1111"},{"last":"2222"}]}`, []string{"data", "-1", "last"},
+			`{"value":"2222"}`, false, false},
+		{"out of bounds array index path", `{"data":[{"last":"1111"}]}`, []string{"data", "1", "last"},
+			`{"value":"{\"data\":[{\"last\":\"1111\"}]}"}`, true, true},
+		{"final array index path", `{"data":["1111","2222"]}`, []string{"data", "1"},
+			`{"value":"2222"}`, false, false},
 	}
 
 	for _, tt := range tests {
@@ -46,3 +52,34 @@ func TestJsonParse_Perform(t *testing.T) {
 		})
 	}
 }
+
+func TestJsonParse_Perform_Expression(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		expression string
+		want       string
+		errored    bool
+	}{
+		{"scalar", `{"data":{"last":"1111"}}`, "data.last", `{"value":"1111"}`, false},
+		{"filter", `{"data":[{"symbol":"BTC","last":"1111"},{"symbol":"ETH","last":"2222"}]}`,
+			`data.#[symbol=="ETH"].last`, `{"value":"2222"}`, false},
+		{"all matches", `{"data":[{"last":"1111"},{"last":"2222"}]}`,
+			"data.#.last", `{"value":["1111","2222"]}`, false},
+		{"wildcard", `{"data":{"price_usd":"1111"}}`, "data.price_*", `{"value":"1111"}`, false},
+		{"nonexistent", `{"data":{}}`, "data.last", `{"value":null}`, false},
+		{"not json", `not json`, "data.last", `{"value":"not json"}`, true},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			input := cltest.RunResultWithValue(test.value)
+			adapter := adapters.JSONParse{Expression: test.expression}
+			result := adapter.Perform(input, nil)
+			assert.JSONEq(t, test.want, result.Data.String())
+			assert.Equal(t, test.errored, result.HasError())
+		})
+	}
+}
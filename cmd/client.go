@@ -81,15 +81,16 @@ func passwordFromFile(pwdFile string) (string, error) {
 }
 
 func logIfNonceOutOfSync(store *strpkg.Store) {
-	account := store.TxManager.GetActiveAccount()
-	lastNonce, err := store.GetLastNonce(account.Address)
-	if err != nil {
-		logger.Warn("database error when checking nonce: ", err)
-		return
-	}
+	for _, account := range store.TxManager.GetActiveAccounts() {
+		lastNonce, err := store.GetLastNonce(account.Address)
+		if err != nil {
+			logger.Warn("database error when checking nonce: ", err)
+			return
+		}
 
-	if localNonceIsNotCurrent(lastNonce, account.GetNonce()) {
-		logger.Warn("The account is being used by another wallet and is not safe to use with chainlink")
+		if localNonceIsNotCurrent(lastNonce, account.GetNonce()) {
+			logger.Warnw("The account is being used by another wallet and is not safe to use with chainlink", "address", account.Address.Hex())
+		}
 	}
 }
 
@@ -181,6 +182,27 @@ func (cli *Client) GetJobSpecs(c *clipkg.Context) error {
 	return cli.errorOut(cli.Render(&jobs))
 }
 
+// DeleteJobSpec archives the given job spec, so that it is no longer run.
+func (cli *Client) DeleteJobSpec(c *clipkg.Context) error {
+	cfg := cli.Config
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id to be deleted"))
+	}
+	resp, err := utils.BasicAuthDelete(
+		cfg.BasicAuthUsername,
+		cfg.BasicAuthPassword,
+		cfg.ClientNodeURL+"/v2/specs/"+c.Args().First(),
+		"application/json",
+		nil,
+	)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var job presenters.JobSpec
+	return cli.renderResponse(resp, &job)
+}
+
 // CreateJobSpec creates job spec based on JSON input
 func (cli *Client) CreateJobSpec(c *clipkg.Context) error {
 	cfg := cli.Config
@@ -240,6 +262,62 @@ func (cli *Client) CreateJobRun(c *clipkg.Context) error {
 	return cli.renderResponse(resp, &jobs)
 }
 
+// ShowJobRun shows the details of a job run and of each of its tasks.
+func (cli *Client) ShowJobRun(c *clipkg.Context) error {
+	cfg := cli.Config
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the run id to be shown"))
+	}
+	resp, err := utils.BasicAuthGet(
+		cfg.BasicAuthUsername,
+		cfg.BasicAuthPassword,
+		cfg.ClientNodeURL+"/v2/runs/"+c.Args().First(),
+	)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var run models.JobRun
+	return cli.renderResponse(resp, &run)
+}
+
+// CancelJobRun stops a pending job run. Runs that have broadcast an
+// Ethereum transaction are only cancelled with the force flag.
+func (cli *Client) CancelJobRun(c *clipkg.Context) error {
+	query := ""
+	if c.Bool("force") {
+		query = "?force=true"
+	}
+	return cli.changeJobRun(c, "cancel", query)
+}
+
+// RetryJobRun executes an errored job run again from its errored tasks
+// onwards.
+func (cli *Client) RetryJobRun(c *clipkg.Context) error {
+	return cli.changeJobRun(c, "retry", "")
+}
+
+func (cli *Client) changeJobRun(c *clipkg.Context, action string, query string) error {
+	cfg := cli.Config
+	if !c.Args().Present() {
+		return cli.errorOut(fmt.Errorf("Must pass the id of the run to %v", action))
+	}
+
+	resp, err := utils.BasicAuthPost(
+		cfg.BasicAuthUsername,
+		cfg.BasicAuthPassword,
+		cfg.ClientNodeURL+"/v2/runs/"+c.Args().First()+"/"+action+query,
+		"application/json",
+		bytes.NewBufferString(""),
+	)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var run models.JobRun
+	return cli.renderResponse(resp, &run)
+}
+
 // BackupDatabase streams a backup of the node's db to the passed filepath.
 func (cli *Client) BackupDatabase(c *clipkg.Context) error {
 	cfg := cli.Config
@@ -389,6 +467,82 @@ func (cli *Client) RemoveBridge(c *clipkg.Context) error {
 	return cli.renderResponse(resp, &bridge)
 }
 
+// GetTransactions returns the transactions sent by the node, newest first.
+func (cli *Client) GetTransactions(c *clipkg.Context) error {
+	cfg := cli.Config
+	requestURI := cfg.ClientNodeURL + "/v2/transactions"
+
+	page := 0
+	if c != nil && c.IsSet("page") {
+		page = c.Int("page")
+	}
+
+	var links jsonapi.Links
+	var txs []presenters.Tx
+	err := cli.getPage(requestURI, page, &txs, &links)
+	if err != nil {
+		return err
+	}
+	return cli.errorOut(cli.Render(&txs))
+}
+
+// ShowTransaction returns the info for the transaction with an attempt of
+// the given hash.
+func (cli *Client) ShowTransaction(c *clipkg.Context) error {
+	cfg := cli.Config
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the hash of the transaction to be shown"))
+	}
+	resp, err := utils.BasicAuthGet(
+		cfg.BasicAuthUsername,
+		cfg.BasicAuthPassword,
+		cfg.ClientNodeURL+"/v2/transactions/"+c.Args().First(),
+	)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var tx presenters.Tx
+	return cli.renderResponse(resp, &tx)
+}
+
+// ReplaceTransaction sends a new attempt of the pending transaction with an
+// attempt of the given hash at a higher gas price.
+func (cli *Client) ReplaceTransaction(c *clipkg.Context) error {
+	return cli.replaceTransaction(c, "replace")
+}
+
+// CancelTransaction replaces the pending transaction with an attempt of the
+// given hash by a zero value transfer to its own account.
+func (cli *Client) CancelTransaction(c *clipkg.Context) error {
+	return cli.replaceTransaction(c, "cancel")
+}
+
+func (cli *Client) replaceTransaction(c *clipkg.Context, action string) error {
+	cfg := cli.Config
+	if !c.Args().Present() {
+		return cli.errorOut(fmt.Errorf("Must pass the hash of the transaction to %v", action))
+	}
+
+	buf := bytes.NewBufferString("")
+	if c.IsSet("gasPrice") {
+		buf = bytes.NewBufferString(fmt.Sprintf(`{"gasPrice":"%v"}`, c.String("gasPrice")))
+	}
+	resp, err := utils.BasicAuthPost(
+		cfg.BasicAuthUsername,
+		cfg.BasicAuthPassword,
+		cfg.ClientNodeURL+"/v2/transactions/"+c.Args().First()+"/"+action,
+		"application/json",
+		buf,
+	)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer resp.Body.Close()
+	var tx presenters.Tx
+	return cli.renderResponse(resp, &tx)
+}
+
 func isDirEmpty(dir string) (bool, error) {
 	f, err := os.Open(dir)
 	if err != nil {
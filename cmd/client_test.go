@@ -1,6 +1,7 @@
 package cmd_test
 
 import (
+	"errors"
 	"flag"
 	"os"
 	"path"
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli"
 )
@@ -153,6 +155,97 @@ func TestClient_ShowJobSpec_NotFound(t *testing.T) {
 	assert.Empty(t, r.Renders)
 }
 
+func TestClient_DeleteJobSpec(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	job := cltest.NewJob()
+	app.Store.SaveJob(&job)
+
+	client, r := cltest.NewClientAndRenderer(app.Store.Config)
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{job.ID})
+	c := cli.NewContext(nil, set, nil)
+	assert.Nil(t, client.DeleteJobSpec(c))
+	assert.Equal(t, 1, len(r.Renders))
+	assert.True(t, r.Renders[0].(*presenters.JobSpec).Archived())
+
+	assert.Error(t, client.DeleteJobSpec(c))
+	assert.Equal(t, 1, len(r.Renders))
+}
+
+func TestClient_ShowJobRun(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	j, initr := cltest.NewJobWithWebInitiator()
+	assert.Nil(t, app.Store.SaveJob(&j))
+	jr := j.NewRun(initr)
+	assert.Nil(t, app.Store.Save(&jr))
+
+	client, r := cltest.NewClientAndRenderer(app.Store.Config)
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{jr.ID})
+	c := cli.NewContext(nil, set, nil)
+	assert.Nil(t, client.ShowJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+	assert.Equal(t, jr.ID, r.Renders[0].(*models.JobRun).ID)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{"garbage"})
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ShowJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+}
+
+func TestClient_CancelJobRun(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{{Type: "NoOp"}}
+	assert.Nil(t, app.Store.SaveJob(&j))
+	jr := j.NewRun(initr)
+	jr.Status = models.RunStatusPendingBridge
+	jr.TaskRuns[0].Status = models.RunStatusPendingBridge
+	assert.Nil(t, app.Store.Save(&jr))
+
+	client, r := cltest.NewClientAndRenderer(app.Store.Config)
+
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("force", false, "")
+	set.Parse([]string{jr.ID})
+	c := cli.NewContext(nil, set, nil)
+	assert.Nil(t, client.CancelJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+	assert.Equal(t, models.RunStatusCancelled, r.Renders[0].(*models.JobRun).Status)
+
+	assert.Error(t, client.CancelJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+}
+
+func TestClient_RetryJobRun(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{{Type: "NoOp"}}
+	assert.Nil(t, app.Store.SaveJob(&j))
+	jr := j.NewRun(initr).MarkErrored(errors.New("unreachable"))
+	assert.Nil(t, app.Store.Save(&jr))
+
+	client, r := cltest.NewClientAndRenderer(app.Store.Config)
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{jr.ID})
+	c := cli.NewContext(nil, set, nil)
+	assert.Nil(t, client.RetryJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+	assert.Equal(t, uint64(1), r.Renders[0].(*models.JobRun).Retries)
+
+	cltest.WaitForJobRunToComplete(t, app.Store, jr)
+	assert.Error(t, client.RetryJobRun(c))
+	assert.Equal(t, 1, len(r.Renders))
+}
+
 func TestClient_CreateJobSpec(t *testing.T) {
 	app, cleanup := cltest.NewApplication()
 	defer cleanup()
@@ -306,6 +399,96 @@ func TestClient_ShowBridge(t *testing.T) {
 	assert.Equal(t, bt.Name, r.Renders[0].(*models.BridgeType).Name)
 }
 
+func TestClient_GetTransactions(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	store := app.Store
+	cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 1)
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 2)
+
+	client, r := cltest.NewClientAndRenderer(store.Config)
+
+	assert.Nil(t, client.GetTransactions(nil))
+	txs := *r.Renders[0].(*[]presenters.Tx)
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, tx.Hash, txs[0].Hash)
+}
+
+func TestClient_ShowTransaction(t *testing.T) {
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	store := app.Store
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 1)
+
+	client, r := cltest.NewClientAndRenderer(store.Config)
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{tx.Hash.Hex()})
+	c := cli.NewContext(nil, set, nil)
+	assert.Nil(t, client.ShowTransaction(c))
+	assert.Equal(t, 1, len(r.Renders))
+	renderedTx := r.Renders[0].(*presenters.Tx)
+	assert.Equal(t, tx.Hash, renderedTx.Hash)
+	assert.Equal(t, 1, len(renderedTx.Attempts))
+}
+
+func TestClient_ReplaceTransaction(t *testing.T) {
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	assert.NoError(t, app.Start())
+	tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), 1)
+
+	client, r := cltest.NewClientAndRenderer(store.Config)
+
+	set := flag.NewFlagSet("replacetx", 0)
+	set.String("gasPrice", "", "")
+	set.Parse([]string{"--gasPrice", "30000000000", tx.Hash.Hex()})
+	c := cli.NewContext(nil, set, nil)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	assert.Nil(t, client.ReplaceTransaction(c))
+	ethMock.EventuallyAllCalled(t)
+	renderedTx := r.Renders[0].(*presenters.Tx)
+	assert.Equal(t, 2, len(renderedTx.Attempts))
+	assert.Equal(t, "30000000000", renderedTx.Attempts[1].GasPrice)
+
+	set = flag.NewFlagSet("replacetx", 0)
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ReplaceTransaction(c))
+}
+
+func TestClient_CancelTransaction(t *testing.T) {
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	assert.NoError(t, app.Start())
+	tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), 1)
+
+	client, r := cltest.NewClientAndRenderer(store.Config)
+
+	set := flag.NewFlagSet("canceltx", 0)
+	set.Parse([]string{tx.Hash.Hex()})
+	c := cli.NewContext(nil, set, nil)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	assert.Nil(t, client.CancelTransaction(c))
+	ethMock.EventuallyAllCalled(t)
+	renderedTx := r.Renders[0].(*presenters.Tx)
+	assert.Equal(t, "cancelling", renderedTx.FriendlyStatus())
+
+	set = flag.NewFlagSet("canceltx", 0)
+	set.Parse([]string{cltest.NewHash().Hex()})
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.CancelTransaction(c))
+}
+
 func TestClient_RemoveBridge(t *testing.T) {
 	app, cleanup := cltest.NewApplication()
 	defer cleanup()
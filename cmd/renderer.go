@@ -52,6 +52,12 @@ func (rt RendererTable) Render(v interface{}) error {
 		rt.renderBridges(*typed)
 	case *presenters.AccountBalance:
 		rt.renderAccountBalance(*typed)
+	case *[]presenters.Tx:
+		rt.renderTxs(*typed)
+	case *presenters.Tx:
+		rt.renderTx(*typed)
+	case *models.JobRun:
+		rt.renderJobRun(*typed)
 	default:
 		return fmt.Errorf("Unable to render object: %v", typed)
 	}
@@ -210,3 +216,80 @@ func (rt RendererTable) renderAccountBalance(ab presenters.AccountBalance) error
 	render("Account Balance", table)
 	return nil
 }
+
+func (rt RendererTable) renderTxs(txs []presenters.Tx) error {
+	table := tablewriter.NewWriter(rt)
+	table.SetHeader([]string{"Hash", "Nonce", "From", "To", "Status", "Attempts"})
+	for _, tx := range txs {
+		table.Append([]string{
+			tx.Hash.Hex(),
+			strconv.FormatUint(tx.Nonce, 10),
+			tx.From.Hex(),
+			tx.To.Hex(),
+			tx.FriendlyStatus(),
+			strconv.Itoa(len(tx.Attempts)),
+		})
+	}
+
+	render("Transactions", table)
+	return nil
+}
+
+func (rt RendererTable) renderTx(tx presenters.Tx) error {
+	table := tablewriter.NewWriter(rt)
+	table.SetHeader([]string{"Hash", "Nonce", "From", "To", "Value", "Gas Limit", "Status"})
+	table.Append([]string{
+		tx.Hash.Hex(),
+		strconv.FormatUint(tx.Nonce, 10),
+		tx.From.Hex(),
+		tx.To.Hex(),
+		tx.Value,
+		strconv.FormatUint(tx.GasLimit, 10),
+		tx.FriendlyStatus(),
+	})
+	render("Transaction", table)
+
+	attempts := tablewriter.NewWriter(rt)
+	attempts.SetHeader([]string{"Hash", "Gas Price", "Sent At", "Status"})
+	for _, a := range tx.Attempts {
+		attempts.Append([]string{
+			a.Hash.Hex(),
+			a.GasPrice,
+			strconv.FormatUint(a.SentAt, 10),
+			a.FriendlyStatus(),
+		})
+	}
+	render("Attempts", attempts)
+	return nil
+}
+
+func (rt RendererTable) renderJobRun(run models.JobRun) error {
+	table := tablewriter.NewWriter(rt)
+	table.SetHeader([]string{"ID", "Job ID", "Status", "Created At", "Completed At", "Retries", "Error"})
+	table.Append([]string{
+		run.ID,
+		run.JobID,
+		string(run.Status),
+		utils.ISO8601UTC(run.CreatedAt),
+		utils.NullISO8601UTC(run.CompletedAt),
+		strconv.FormatUint(run.Retries, 10),
+		run.Result.ErrorMessage.String,
+	})
+	render("Run", table)
+
+	tasks := tablewriter.NewWriter(rt)
+	tasks.SetHeader([]string{"Type", "Status", "Input", "Output", "Error", "Started At", "Finished At"})
+	for _, tr := range run.TaskRuns {
+		tasks.Append([]string{
+			tr.Task.Type,
+			string(tr.Status),
+			tr.Input.String(),
+			tr.Result.Data.String(),
+			tr.Result.ErrorMessage.String,
+			utils.NullISO8601UTC(tr.StartedAt),
+			utils.NullISO8601UTC(tr.FinishedAt),
+		})
+	}
+	render("Tasks", tasks)
+	return nil
+}
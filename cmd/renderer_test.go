@@ -2,6 +2,7 @@ package cmd_test
 
 import (
 	"bytes"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -57,6 +58,41 @@ func TestRendererTableRenderBridge(t *testing.T) {
 	assert.Equal(t, tw.found, true)
 }
 
+func TestRendererTableRenderTx(t *testing.T) {
+	hash := cltest.NewHash()
+	tx := presenters.Tx{
+		Hash:     cltest.NewHash(),
+		Value:    "0",
+		Attempts: []presenters.TxAttempt{{Hash: hash, GasPrice: "20000000000", SentAt: 1}},
+	}
+	tw := &testWriter{hash.Hex(), t, false}
+	r := cmd.RendererTable{Writer: tw}
+	assert.Nil(t, r.Render(&tx))
+	assert.Equal(t, tw.found, true)
+}
+
+func TestRendererTableRenderTxs(t *testing.T) {
+	tx := presenters.Tx{Hash: cltest.NewHash(), Value: "0"}
+	tw := &testWriter{tx.Hash.Hex(), t, false}
+	r := cmd.RendererTable{Writer: tw}
+	assert.Nil(t, r.Render(&[]presenters.Tx{tx}))
+	assert.Equal(t, tw.found, true)
+}
+
+func TestRendererTableRenderJobRun(t *testing.T) {
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: "NoOp"}, {Type: "EthTx"}}
+	run := job.NewRun(initr)
+	run.TaskRuns[1] = run.TaskRuns[1].ApplyResult(models.RunResult{}.WithError(errors.New("out of gas")))
+
+	for _, want := range []string{run.ID, "EthTx", "out of gas"} {
+		tw := &testWriter{want, t, false}
+		r := cmd.RendererTable{Writer: tw}
+		assert.Nil(t, r.Render(&run))
+		assert.Equal(t, tw.found, true)
+	}
+}
+
 func TestRendererTableRenderUnknown(t *testing.T) {
 	r := cmd.RendererTable{Writer: ioutil.Discard}
 	anon := struct{ Name string }{"Romeo"}
@@ -81,6 +81,7 @@ func NewConfigWithWSServer(wsserver *httptest.Server) *TestConfig {
 			EthGasBumpWei:        *big.NewInt(5000000000),
 			EthGasBumpThreshold:  3,
 			EthGasPriceDefault:   *big.NewInt(20000000000),
+			EthGasPriceMax:       *big.NewInt(500000000000),
 			DatabasePollInterval: store.Duration{Duration: time.Millisecond * 500},
 		},
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -81,6 +82,19 @@ func (mock *EthMock) RegisterError(method, errMsg string) {
 	mock.Responses = append(mock.Responses, res)
 }
 
+// RegisterConnectionError registers a dropped connection as the outcome of
+// the next call to the method, as if the node could not be reached
+func (mock *EthMock) RegisterConnectionError(method string) {
+	res := MockResponse{
+		methodName: method,
+		err:        &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")},
+	}
+
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	mock.Responses = append(mock.Responses, res)
+}
+
 // AllCalled return true if all mocks have been mocked
 func (mock *EthMock) AllCalled() bool {
 	mock.mutex.RLock()
@@ -103,6 +117,9 @@ func (mock *EthMock) Call(result interface{}, method string, args ...interface{}
 	for i, resp := range mock.Responses {
 		if resp.methodName == method {
 			mock.Responses = append(mock.Responses[:i], mock.Responses[i+1:]...)
+			if resp.err != nil {
+				return resp.err
+			}
 			if resp.hasError {
 				return fmt.Errorf(resp.errMsg)
 			}
@@ -251,6 +268,7 @@ type MockResponse struct {
 	response   interface{}
 	errMsg     string
 	hasError   bool
+	err        error
 	callback   func(interface{}, ...interface{}) error
 }
 
@@ -326,6 +344,31 @@ func (NeverClock) Now() time.Time {
 	return time.Now()
 }
 
+// TriggerClock a clock whose After channels all fire when triggered
+type TriggerClock struct {
+	triggered chan time.Time
+}
+
+// NewTriggerClock returns a clock that has not been triggered
+func NewTriggerClock() *TriggerClock {
+	return &TriggerClock{triggered: make(chan time.Time)}
+}
+
+// Trigger fires the After channels returned so far and from now on
+func (tc *TriggerClock) Trigger() {
+	close(tc.triggered)
+}
+
+// After return channel of time that fires when triggered
+func (tc *TriggerClock) After(_ time.Duration) <-chan time.Time {
+	return tc.triggered
+}
+
+// Now returns current local time
+func (*TriggerClock) Now() time.Time {
+	return time.Now()
+}
+
 // RendererMock a mock renderer
 type RendererMock struct {
 	Renders []interface{}
@@ -481,6 +524,7 @@ type MockHeadTrackable struct {
 	connectedCount    int32
 	disconnectedCount int32
 	onNewHeadCount    int32
+	onReorgCount      int32
 }
 
 // Connect increases the connected count by one
@@ -510,6 +554,16 @@ func (m *MockHeadTrackable) OnNewHeadCount() int32 {
 	return atomic.LoadInt32(&m.onNewHeadCount)
 }
 
+// OnReorg increases the OnReorgCount count by one
+func (m *MockHeadTrackable) OnReorg(*models.IndexableBlockNumber) {
+	atomic.AddInt32(&m.onReorgCount, 1)
+}
+
+// OnReorgCount returns the count of reorganizations, safely.
+func (m *MockHeadTrackable) OnReorgCount() int32 {
+	return atomic.LoadInt32(&m.onReorgCount)
+}
+
 // NeverSleeper is a struct that never sleeps
 type NeverSleeper struct{}
 
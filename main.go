@@ -70,6 +70,13 @@ func Run(client *cmd.Client, args ...string) {
 					Usage: "page of results to display",
 				},
 			},
+			Subcommands: []cli.Command{
+				{
+					Name:   "delete",
+					Usage:  "Archive a specific job, stopping its runs while keeping its history",
+					Action: client.DeleteJobSpec,
+				},
+			},
 		},
 		{
 			Name:    "show",
@@ -89,6 +96,33 @@ func Run(client *cmd.Client, args ...string) {
 			Usage:   "Begin job run for specid",
 			Action:  client.CreateJobRun,
 		},
+		{
+			Name:  "runs",
+			Usage: "Manage job runs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "show",
+					Usage:  "Show a specific job run and the results of its tasks",
+					Action: client.ShowJobRun,
+				},
+				{
+					Name:   "cancel",
+					Usage:  "Cancel a pending job run",
+					Action: client.CancelJobRun,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "force, f",
+							Usage: "cancel even if the run has broadcast an Ethereum transaction",
+						},
+					},
+				},
+				{
+					Name:   "retry",
+					Usage:  "Execute an errored job run again from its errored tasks",
+					Action: client.RetryJobRun,
+				},
+			},
+		},
 		{
 			Name:   "backup",
 			Usage:  "Backup the database of the running node",
@@ -126,6 +160,44 @@ func Run(client *cmd.Client, args ...string) {
 			Usage:  "Removes a specific bridge",
 			Action: client.RemoveBridge,
 		},
+		{
+			Name:   "txs",
+			Usage:  "List the transactions sent by the node",
+			Action: client.GetTransactions,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:   "tx",
+			Usage:  "Show a specific transaction and its attempts",
+			Action: client.ShowTransaction,
+		},
+		{
+			Name:   "replacetx",
+			Usage:  "Resend a pending transaction at a higher gas price",
+			Action: client.ReplaceTransaction,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "gasPrice",
+					Usage: "gas price in Wei, by default the last price bumped by ETH_GAS_BUMP_WEI",
+				},
+			},
+		},
+		{
+			Name:   "canceltx",
+			Usage:  "Replace a pending transaction with a transfer to its own account",
+			Action: client.CancelTransaction,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "gasPrice",
+					Usage: "gas price in Wei, by default the last price bumped by ETH_GAS_BUMP_WEI",
+				},
+			},
+		},
 	}
 	app.Run(args)
 }
@@ -26,9 +26,13 @@ type ChainlinkApplication struct {
 	HeadTracker          *HeadTracker
 	JobSubscriber        *JobSubscriber
 	Scheduler            *Scheduler
+	Retrier              *Retrier
+	TxReconciler         *TxReconciler
+	TxConfirmer          *TxConfirmer
 	Store                *store.Store
 	Exiter               func(int)
 	jobSubscriberID      string
+	txConfirmerID        string
 	specAndRunSubscriber *SpecAndRunSubscriber
 	specSubscriberID     string
 	bridgeTypeMutex      sync.Mutex
@@ -45,14 +49,17 @@ func NewApplication(config store.Config) Application {
 		HeadTracker:          ht,
 		JobSubscriber:        &JobSubscriber{Store: store},
 		Scheduler:            NewScheduler(store),
+		Retrier:              NewRetrier(store, ht),
+		TxReconciler:         NewTxReconciler(store),
+		TxConfirmer:          &TxConfirmer{Store: store},
 		Store:                store,
 		Exiter:               os.Exit,
 		specAndRunSubscriber: NewSpecAndRunSubscriber(store, config.OracleContractAddress),
 	}
 }
 
-// Start runs the JobSubscriber and Scheduler. If successful,
-// nil will be returned.
+// Start resumes the runs left unfinished when the node last stopped, then
+// runs the JobSubscriber and Scheduler. If successful, nil will be returned.
 // Also listens for interrupt signals from the operating system so
 // that the application can be properly closed before the application
 // exits.
@@ -66,8 +73,19 @@ func (app *ChainlinkApplication) Start() error {
 	}()
 
 	app.jobSubscriberID = app.HeadTracker.Attach(app.JobSubscriber)
+	app.txConfirmerID = app.HeadTracker.Attach(app.TxConfirmer)
 	app.specSubscriberID = app.HeadTracker.Attach(app.specAndRunSubscriber)
-	return multierr.Combine(app.Store.Start(), app.HeadTracker.Start(), app.Scheduler.Start())
+	err := multierr.Combine(app.Store.Start(), app.HeadTracker.Load())
+	// Runs are resumed before new heads are received, so that they are not
+	// also resumed by the HeadTrackables at the same time.
+	logger.WarnIf(ResumeUnfinishedRuns(app.Store, app.HeadTracker.LastRecord()))
+	return multierr.Combine(
+		err,
+		app.HeadTracker.Start(),
+		app.Scheduler.Start(),
+		app.Retrier.Start(),
+		app.TxReconciler.Start(),
+	)
 }
 
 // Stop allows the application to exit by halting schedules, closing
@@ -76,8 +94,11 @@ func (app *ChainlinkApplication) Stop() error {
 	defer logger.Sync()
 	logger.Info("Gracefully exiting...")
 	app.Scheduler.Stop()
+	app.Retrier.Stop()
+	app.TxReconciler.Stop()
 	app.HeadTracker.Stop()
 	app.HeadTracker.Detach(app.jobSubscriberID)
+	app.HeadTracker.Detach(app.txConfirmerID)
 	app.HeadTracker.Detach(app.specSubscriberID)
 	return app.Store.Close()
 }
@@ -100,6 +121,59 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 	return app.JobSubscriber.AddJob(job, app.HeadTracker.LastRecord())
 }
 
+// UpdateJob swaps the subscriptions of the previous version of the job for
+// those of the next, then saves the next version and swaps the schedules.
+// If either the swap or the save fails, the previous version is kept. Runs
+// made with the previous version keep referencing it.
+func (app *ChainlinkApplication) UpdateJob(previous models.JobSpec, next *models.JobSpec) error {
+	if err := app.Store.CreateInitiators(next); err != nil {
+		return err
+	}
+
+	bn := app.HeadTracker.LastRecord()
+	if err := app.JobSubscriber.ReplaceJob(*next, bn); err != nil {
+		app.deleteCreatedInitiators(previous, *next)
+		return err
+	}
+	if err := app.Store.UpdateJob(previous, next); err != nil {
+		logger.WarnIf(app.JobSubscriber.ReplaceJob(previous, bn))
+		app.deleteCreatedInitiators(previous, *next)
+		return err
+	}
+
+	app.Scheduler.RemoveJob(next.ID)
+	app.Scheduler.AddJob(*next)
+	return nil
+}
+
+// deleteCreatedInitiators deletes the initiators saved for the next version
+// of a job that the previous version does not have.
+func (app *ChainlinkApplication) deleteCreatedInitiators(previous, next models.JobSpec) {
+	kept := map[int]bool{}
+	for _, initr := range previous.Initiators {
+		kept[initr.ID] = true
+	}
+	for i, initr := range next.Initiators {
+		if initr.ID != 0 && !kept[initr.ID] {
+			logger.WarnIf(app.Store.DeleteStruct(&next.Initiators[i]))
+		}
+	}
+}
+
+// RemoveJob archives the job with the given ID, keeping it and its runs in
+// the store, and stops its schedules and subscriptions so that it is no
+// longer run.
+func (app *ChainlinkApplication) RemoveJob(id string) (models.JobSpec, error) {
+	job, err := app.Store.ArchiveJob(id, app.Store.Clock.Now())
+	if err != nil {
+		return job, err
+	}
+
+	app.Scheduler.RemoveJob(id)
+	app.JobSubscriber.RemoveJob(id)
+	return job, nil
+}
+
 // AddAdapter adds an adapter to the store. If another
 // adapter with the same name already exists the adapter
 // will not be added.
@@ -3,9 +3,12 @@ package services
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/asdine/storm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
@@ -14,12 +17,17 @@ import (
 	"github.com/smartcontractkit/chainlink/utils"
 )
 
+// headHistoryDepth is the number of recent block headers the HeadTracker
+// remembers in order to detect chain reorganizations.
+const headHistoryDepth = 50
+
 // HeadTrackable represents any object that wishes to respond to ethereum events,
 // after being attached to HeadTracker.
 type HeadTrackable interface {
 	Connect(*models.IndexableBlockNumber) error
 	Disconnect()
 	OnNewHead(*models.BlockHeader)
+	OnReorg(*models.IndexableBlockNumber)
 }
 
 // HeadTracker holds and stores the latest block number experienced by this particular node
@@ -31,6 +39,7 @@ type HeadTracker struct {
 	headSubscription       models.EthSubscription
 	store                  *store.Store
 	number                 *models.IndexableBlockNumber
+	history                []models.IndexableBlockNumber
 	headMutex              sync.RWMutex
 	trackersMutex          sync.RWMutex
 	connected              bool
@@ -61,14 +70,9 @@ func NewHeadTracker(store *store.Store, sleepers ...utils.Sleeper) *HeadTracker
 // subscribes to new heads, and if successful fires Connect on the
 // HeadTrackable argument.
 func (ht *HeadTracker) Start() error {
-	numbers := []models.IndexableBlockNumber{}
-	err := ht.store.Select().OrderBy("Digits", "Number").Limit(1).Reverse().Find(&numbers)
-	if err != nil && err != storm.ErrNotFound {
+	if err := ht.Load(); err != nil {
 		return err
 	}
-	if len(numbers) > 0 {
-		ht.number = &numbers[0]
-	}
 
 	ht.headers = make(chan models.BlockHeader)
 	sub, err := ht.subscribeToNewHeads(ht.headers)
@@ -82,6 +86,26 @@ func (ht *HeadTracker) Start() error {
 	return nil
 }
 
+// Load retrieves the recently tracked block numbers persisted in the store,
+// so that the last one is known before the HeadTracker is started.
+func (ht *HeadTracker) Load() error {
+	numbers := []models.IndexableBlockNumber{}
+	err := ht.store.Select().OrderBy("Digits", "Number").Limit(headHistoryDepth).Reverse().Find(&numbers)
+	if err != nil && err != storm.ErrNotFound {
+		return err
+	}
+	ht.headMutex.Lock()
+	defer ht.headMutex.Unlock()
+	ht.history = []models.IndexableBlockNumber{}
+	for i := len(numbers) - 1; i >= 0; i-- {
+		ht.history = append(ht.history, numbers[i])
+	}
+	if len(numbers) > 0 {
+		ht.number = &numbers[0]
+	}
+	return nil
+}
+
 // Stop unsubscribes all connections and fires Disconnect.
 func (ht *HeadTracker) Stop() error {
 	if ht.headSubscription == nil {
@@ -111,10 +135,44 @@ func (ht *HeadTracker) Save(n *models.IndexableBlockNumber) error {
 		copy := *n
 		ht.number = &copy
 	}
+	ht.recordHistory(*n)
 	ht.headMutex.Unlock()
 	return ht.store.Save(n)
 }
 
+// recordHistory keeps the window of recent block numbers ordered by height,
+// replacing any previous block seen at the same height. Not thread safe.
+func (ht *HeadTracker) recordHistory(n models.IndexableBlockNumber) {
+	history := []models.IndexableBlockNumber{}
+	inserted := false
+	for _, bn := range ht.history {
+		cmp := bn.ToInt().Cmp(n.ToInt())
+		if cmp == 0 {
+			continue
+		} else if cmp > 0 && !inserted {
+			history = append(history, n)
+			inserted = true
+		}
+		history = append(history, bn)
+	}
+	if !inserted {
+		history = append(history, n)
+	}
+	if len(history) > headHistoryDepth {
+		history = history[len(history)-headHistoryDepth:]
+	}
+	ht.history = history
+}
+
+// History returns the recent block numbers being tracked, oldest first.
+func (ht *HeadTracker) History() []models.IndexableBlockNumber {
+	ht.headMutex.RLock()
+	defer ht.headMutex.RUnlock()
+	history := make([]models.IndexableBlockNumber, len(ht.history))
+	copy(history, ht.history)
+	return history
+}
+
 // LastRecord returns the latest block header being tracked, or nil.
 func (ht *HeadTracker) LastRecord() *models.IndexableBlockNumber {
 	ht.headMutex.RLock()
@@ -175,6 +233,14 @@ func (ht *HeadTracker) onNewHead(head *models.BlockHeader) {
 	}
 }
 
+func (ht *HeadTracker) onReorg(ancestor *models.IndexableBlockNumber) {
+	ht.trackersMutex.RLock()
+	defer ht.trackersMutex.RUnlock()
+	for _, t := range ht.trackers {
+		t.OnReorg(ancestor)
+	}
+}
+
 func (ht *HeadTracker) subscribeToNewHeads(headers chan models.BlockHeader) (models.EthSubscription, error) {
 	sub, err := ht.store.TxManager.SubscribeToNewHeads(headers)
 	if err != nil {
@@ -219,6 +285,7 @@ func (ht *HeadTracker) listenToNewHeads() {
 		case header := <-ht.headers:
 			number := header.ToIndexableBlockNumber()
 			logger.Debugw(fmt.Sprintf("Received header %v with hash %s", presenters.FriendlyBigInt(number.ToInt()), header.Hash().String()), "hash", header.Hash())
+			ht.handleReorg(header)
 			if err := ht.Save(number); err != nil {
 				logger.Error(err.Error())
 			} else {
@@ -234,14 +301,113 @@ func (ht *HeadTracker) reconnectLoop() {
 	ht.sleeper.Reset()
 	for {
 		ht.Stop()
-		logger.Info("Reconnecting to node ", ht.store.Config.EthereumURL, " in ", ht.sleeper.Duration())
+		logger.Info("Reconnecting to Ethereum node in ", ht.sleeper.Duration())
 		ht.sleeper.Sleep()
 		err := ht.Start()
 		if err != nil {
-			logger.Warnw(fmt.Sprintf("Error reconnecting to %v", ht.store.Config.EthereumURL), "err", err)
+			logger.Warnw("Error reconnecting to Ethereum node", "err", err)
 		} else {
-			logger.Info("Reconnected to node ", ht.store.Config.EthereumURL)
+			logger.Info("Reconnected to Ethereum node")
 			break
 		}
 	}
 }
+
+// handleReorg checks the incoming head against the recent history and, if
+// the chain has been reorganized, discards the orphaned blocks and notifies
+// the HeadTrackables with the last block shared by both chains.
+func (ht *HeadTracker) handleReorg(head models.BlockHeader) {
+	if !ht.isReorg(head) {
+		return
+	}
+
+	ancestor := ht.commonAncestor(head)
+	logger.Warnw(
+		fmt.Sprintf("Chain reorganization detected at block %v, rolling back to block %v",
+			presenters.FriendlyBigInt(head.Number.ToInt()),
+			presenters.FriendlyBigInt(ancestor.ToInt())),
+		"hash", head.Hash(), "parentHash", head.ParentHash, "ancestor", ancestor.Hash)
+	ht.rollback(ancestor)
+	ht.onReorg(ancestor)
+}
+
+// isReorg returns true if the head has a different hash from the block
+// tracked at the same height, or if its parent is not the block tracked at
+// the previous height. Heads behind the latest block are otherwise expected,
+// as a lagging node or a failover can send them again.
+func (ht *HeadTracker) isReorg(head models.BlockHeader) bool {
+	number := head.Number.ToInt()
+	parentNumber := new(big.Int).Sub(number, big.NewInt(1))
+	for _, bn := range ht.History() {
+		if bn.ToInt().Cmp(number) == 0 && bn.Hash != head.Hash() {
+			return true
+		}
+		if bn.ToInt().Cmp(parentNumber) == 0 && bn.Hash != head.ParentHash {
+			return true
+		}
+	}
+	return false
+}
+
+// commonAncestor walks back through the tracked history until it finds a
+// block that is still part of the canonical chain. If the reorganization is
+// deeper than the history, the block before the oldest tracked one is used.
+func (ht *HeadTracker) commonAncestor(head models.BlockHeader) *models.IndexableBlockNumber {
+	number := head.Number.ToInt()
+	history := ht.History()
+	for i := len(history) - 1; i >= 0; i-- {
+		bn := history[i]
+		if bn.ToInt().Cmp(number) >= 0 {
+			continue
+		}
+		canonical, err := ht.canonicalHash(bn, head)
+		if err != nil {
+			logger.Warnw("Unable to retrieve canonical block while handling reorganization", "err", err)
+			break
+		}
+		if canonical == bn.Hash {
+			return &bn
+		}
+	}
+
+	oldest := number
+	if len(history) > 0 && history[0].ToInt().Cmp(oldest) < 0 {
+		oldest = history[0].ToInt()
+	}
+	return models.NewIndexableBlockNumber(new(big.Int).Sub(oldest, big.NewInt(1)), common.Hash{})
+}
+
+func (ht *HeadTracker) canonicalHash(bn models.IndexableBlockNumber, head models.BlockHeader) (common.Hash, error) {
+	parentNumber := new(big.Int).Sub(head.Number.ToInt(), big.NewInt(1))
+	if bn.ToInt().Cmp(parentNumber) == 0 && bn.Hash == head.ParentHash {
+		return head.ParentHash, nil
+	}
+	header, err := ht.store.TxManager.GetBlockByNumber(hexutil.EncodeBig(bn.ToInt()))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Hash(), nil
+}
+
+// rollback forgets every tracked block after the ancestor, both in memory
+// and in the store, so that the orphaned chain is not resumed on reboot.
+func (ht *HeadTracker) rollback(ancestor *models.IndexableBlockNumber) {
+	ht.headMutex.Lock()
+	defer ht.headMutex.Unlock()
+
+	history := []models.IndexableBlockNumber{}
+	for _, bn := range ht.history {
+		if bn.GreaterThan(ancestor) {
+			orphaned := bn
+			logger.WarnIf(ht.store.DeleteStruct(&orphaned))
+		} else {
+			history = append(history, bn)
+		}
+	}
+	ht.history = history
+
+	if ht.number.GreaterThan(ancestor) {
+		copy := *ancestor
+		ht.number = &copy
+	}
+}
@@ -28,6 +28,23 @@ func TestHeadTracker_New(t *testing.T) {
 	assert.Equal(t, last.Number, ht.LastRecord().Number)
 }
 
+func TestHeadTracker_Load(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	assert.Nil(t, store.Save(cltest.IndexableBlockNumber(1)))
+	last := cltest.IndexableBlockNumber(16)
+	assert.Nil(t, store.Save(last))
+
+	ht := services.NewHeadTracker(store)
+	assert.Nil(t, ht.LastRecord())
+	assert.Nil(t, ht.Load())
+	assert.Equal(t, last.Number, ht.LastRecord().Number)
+	assert.Len(t, ht.History(), 2)
+	assert.False(t, ht.IsConnected())
+}
+
 func TestHeadTracker_Get(t *testing.T) {
 	t.Parallel()
 
@@ -152,3 +169,90 @@ func TestHeadTracker_ReconnectOnError(t *testing.T) {
 	assert.Equal(t, int32(2), checker.ConnectedCount())
 	assert.Equal(t, int32(1), checker.DisconnectedCount())
 }
+
+func TestHeadTracker_Reorg(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+	ht := services.NewHeadTracker(store, cltest.NeverSleeper{})
+
+	checker := &cltest.MockHeadTrackable{}
+	ht.Attach(checker)
+
+	headers := make(chan models.BlockHeader)
+	eth.RegisterSubscription("newHeads", headers)
+
+	assert.Nil(t, ht.Start())
+	defer ht.Stop()
+
+	one := models.BlockHeader{Number: cltest.BigHexInt(1), ParityHash: cltest.NewHash()}
+	two := models.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: cltest.NewHash(), ParentHash: one.Hash()}
+	uncle := models.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: cltest.NewHash(), ParentHash: one.Hash()}
+	three := models.BlockHeader{Number: cltest.BigHexInt(3), ParityHash: cltest.NewHash(), ParentHash: uncle.Hash()}
+
+	headers <- one
+	headers <- two
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(2)))
+	assert.Equal(t, int32(0), checker.OnReorgCount())
+
+	headers <- uncle
+	g.Eventually(func() int32 { return checker.OnReorgCount() }).Should(gomega.Equal(int32(1)))
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(3)))
+
+	history := ht.History()
+	assert.Len(t, history, 2)
+	assert.Equal(t, uncle.Hash(), history[1].Hash)
+	assert.Equal(t, uncle.Hash(), ht.LastRecord().Hash)
+
+	headers <- three
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(4)))
+	assert.Equal(t, int32(1), checker.OnReorgCount())
+
+	headers <- uncle
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(5)))
+	assert.Equal(t, int32(1), checker.OnReorgCount())
+	assert.Equal(t, three.Hash(), ht.LastRecord().Hash)
+}
+
+func TestHeadTracker_Reorg_NewHeightWithOtherParent(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+	ht := services.NewHeadTracker(store, cltest.NeverSleeper{})
+
+	checker := &cltest.MockHeadTrackable{}
+	ht.Attach(checker)
+
+	headers := make(chan models.BlockHeader)
+	eth.RegisterSubscription("newHeads", headers)
+
+	assert.Nil(t, ht.Start())
+	defer ht.Stop()
+
+	one := models.BlockHeader{Number: cltest.BigHexInt(1), ParityHash: cltest.NewHash()}
+	twoA := models.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: cltest.NewHash(), ParentHash: one.Hash()}
+	twoB := models.BlockHeader{Number: cltest.BigHexInt(2), ParityHash: cltest.NewHash(), ParentHash: one.Hash()}
+	threeB := models.BlockHeader{Number: cltest.BigHexInt(3), ParityHash: cltest.NewHash(), ParentHash: twoB.Hash()}
+
+	headers <- one
+	headers <- twoA
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(2)))
+
+	eth.Register("eth_getBlockByNumber", twoB)
+	eth.Register("eth_getBlockByNumber", one)
+	headers <- threeB
+	g.Eventually(func() int32 { return checker.OnReorgCount() }).Should(gomega.Equal(int32(1)))
+	g.Eventually(func() int32 { return checker.OnNewHeadCount() }).Should(gomega.Equal(int32(3)))
+	eth.EventuallyAllCalled(t)
+
+	history := ht.History()
+	assert.Len(t, history, 2)
+	assert.Equal(t, one.Hash(), history[0].Hash)
+	assert.Equal(t, threeB.Hash(), history[1].Hash)
+}
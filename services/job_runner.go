@@ -3,12 +3,19 @@ package services
 import (
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
+	"sync"
 
+	"github.com/asdine/storm"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // BeginRun creates a new run if the job is valid and starts the job.
@@ -34,22 +41,82 @@ func BeginRunAtBlock(
 	if err != nil {
 		return models.JobRun{}, err
 	}
-	if input.Amount != nil &&
-		store.Config.MinimumContractPayment.Cmp(input.Amount) > 0 {
-		msg := fmt.Sprintf(
+	if input.Amount != nil {
+		if err := validatePayment(job, input.Amount, store); err != nil {
+			logger.Warnw(err.Error(), run.ForLogger("payment", input.Amount)...)
+			run = run.ApplyResult(input.WithError(err))
+		}
+	}
+	return ExecuteRunAtBlock(run, store, input, bn)
+}
+
+// MinimumPayment returns the least amount of LINK the node accepts for a run
+// of the job: the job's MinPayment if set, or else the sum of the payments
+// required by its adapters and bridges. It is never below the node's
+// MinimumContractPayment.
+func MinimumPayment(job models.JobSpec, store *store.Store) (*big.Int, error) {
+	min := new(big.Int)
+	if job.MinPayment != nil {
+		min.Set((*big.Int)(job.MinPayment))
+	} else {
+		for _, task := range job.Tasks {
+			payment, err := taskPayment(task, store)
+			if err != nil {
+				return nil, err
+			}
+			min.Add(min, payment)
+		}
+	}
+
+	if min.Cmp(&store.Config.MinimumContractPayment) < 0 {
+		min.Set(&store.Config.MinimumContractPayment)
+	}
+	return min, nil
+}
+
+// taskPayment returns the payment required by the task's bridge, or by its
+// adapter if it is not a bridge.
+func taskPayment(task models.TaskSpec, store *store.Store) (*big.Int, error) {
+	var payment *assets.Link
+	bt, err := store.BridgeTypeFor(task.Type)
+	if err == nil {
+		payment = bt.MinPayment
+	} else if err == storm.ErrNotFound {
+		payment = store.Config.AdapterPayments.For(task.Type)
+	} else {
+		return nil, err
+	}
+
+	if payment == nil {
+		return new(big.Int), nil
+	}
+	return (*big.Int)(payment), nil
+}
+
+func validatePayment(job models.JobSpec, amount *big.Int, store *store.Store) error {
+	min, err := MinimumPayment(job, store)
+	if err != nil {
+		return fmt.Errorf("Rejecting job %s, unable to determine its minimum payment: %v", job.ID, err)
+	}
+	if min.Cmp(amount) > 0 {
+		return fmt.Errorf(
 			"Rejecting job %s with payment %s below minimum threshold (%s)",
 			job.ID,
-			input.Amount,
-			store.Config.MinimumContractPayment.Text(10))
-		run = run.ApplyResult(input.WithError(errors.New(msg)))
+			amount,
+			min.Text(10))
 	}
-	return ExecuteRunAtBlock(run, store, input, bn)
+	return nil
 }
 
 // BuildRun checks to ensure the given job has not started or ended before
 // creating a new run for the job.
 func BuildRun(job models.JobSpec, i models.Initiator, store *store.Store) (models.JobRun, error) {
 	now := store.Clock.Now()
+	if job.Archived() {
+		return models.JobRun{}, JobRunnerError{
+			msg: fmt.Sprintf("Job runner: Job %v archived at %v", job.ID, job.ArchivedAt.Time),
+		}
+	}
 	if !job.Started(now) {
 		return models.JobRun{}, JobRunnerError{
 			msg: fmt.Sprintf("Job runner: Job %v unstarted: %v before job's start time %v", job.ID, now, job.EndAt),
@@ -63,24 +130,184 @@ func BuildRun(job models.JobSpec, i models.Initiator, store *store.Store) (model
 	return job.NewRun(i), nil
 }
 
+// ResumeUnfinishedRuns resumes the runs that were unstarted, in progress or
+// pending confirmations when the node stopped. Runs that cannot be safely
+// re-executed are errored instead.
+func ResumeUnfinishedRuns(store *store.Store, bn *models.IndexableBlockNumber) error {
+	runs, err := store.JobRunsWithStatus(
+		models.RunStatusUnstarted,
+		models.RunStatusInProgress,
+		models.RunStatusPendingConfirmations,
+	)
+	if err != nil {
+		return fmt.Errorf("ResumeUnfinishedRuns: %v", err)
+	}
+
+	for _, jr := range runs {
+		if err := resumable(jr); err != nil {
+			logger.Warnw(fmt.Sprintf("Unable to resume run: %v", err), jr.ForLogger()...)
+			jr = jr.MarkErrored(err)
+			if err := store.Save(&jr); err != nil {
+				logger.Error(err.Error())
+			}
+			continue
+		}
+
+		logger.Infow("Resuming run", jr.ForLogger()...)
+		if _, err := ExecuteRunAtBlock(jr, store, models.RunResult{}, bn); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+	return nil
+}
+
+// resumable returns an error if re-executing the run could repeat a side
+// effect or run a task without its original input.
+func resumable(jr models.JobRun) error {
+	for _, i := range jr.ReadyTaskRuns() {
+		tr := jr.TaskRuns[i]
+		if tr.Status.InProgress() && strings.ToLower(tr.Task.Type) == "ethtx" {
+			return errors.New("an Ethereum transaction may already have been sent")
+		}
+		if tr.Status.Unstarted() && len(jr.TaskRunParents(i)) == 0 && initiatorHasInput(jr.Initiator) {
+			return errors.New("the run's input was not saved")
+		}
+	}
+	return nil
+}
+
+// CancelRun moves the run and its unfinished tasks to the cancelled status,
+// so that it is no longer resumed. The run is not cancelled if it has changed
+// since it was read, and executions of the run still going stop at their
+// next save.
+func CancelRun(jr models.JobRun, store *store.Store) (models.JobRun, error) {
+	cancelled, err := store.CancelJobRun(jr)
+	if err == models.ErrJobRunChanged {
+		return cancelled, JobRunnerError{
+			msg: fmt.Sprintf("Job runner: run %v changed while being cancelled, it is now %v", jr.ID, cancelled.Status),
+		}
+	} else if err != nil {
+		return jr, wrapError(jr, err)
+	}
+	logger.Infow("Cancelled run", cancelled.ForLogger()...)
+	return cancelled, nil
+}
+
+// EthTxBroadcast returns true if an EthTx task of the run has sent its
+// Ethereum transaction, or may be sending it.
+func EthTxBroadcast(jr models.JobRun, store *store.Store) (bool, error) {
+	for _, tr := range jr.TaskRuns {
+		if sent, err := ethTxSent(tr, store); err != nil || sent {
+			return sent, err
+		}
+	}
+	return false, nil
+}
+
+func ethTxSent(tr models.TaskRun, store *store.Store) (bool, error) {
+	if strings.ToLower(tr.Task.Type) != "ethtx" || tr.Status.Unstarted() {
+		return false, nil
+	} else if tr.Status.InProgress() {
+		return true, nil
+	}
+
+	hash, err := tr.Result.Value()
+	if err != nil {
+		return false, nil
+	}
+	if _, err := store.FindTxByAttempt(common.HexToHash(hash)); err == nil {
+		return true, nil
+	} else if err != storm.ErrNotFound {
+		return false, err
+	}
+	return false, nil
+}
+
+// RetryRun clears the error of the run's errored tasks and saves it, ready
+// to be executed again from those tasks onwards. Runs whose errored EthTx
+// task has sent its transaction are not retried, so that it is not sent
+// twice, and neither are runs changed in the store since they were read.
+func RetryRun(jr models.JobRun, store *store.Store) (models.JobRun, error) {
+	errored := false
+	for _, tr := range jr.TaskRuns {
+		if !tr.Status.Errored() {
+			continue
+		}
+		errored = true
+		if sent, err := ethTxSent(tr, store); err != nil {
+			return jr, wrapError(jr, err)
+		} else if sent {
+			return jr, JobRunnerError{
+				msg: fmt.Sprintf("Job runner: run %v has sent the Ethereum transaction of its errored task", jr.ID),
+			}
+		}
+	}
+	if !jr.Status.Errored() || !errored {
+		return jr, JobRunnerError{
+			msg: fmt.Sprintf("Job runner: run %v has no errored task to retry", jr.ID),
+		}
+	}
+
+	retried, err := store.RetryJobRun(jr, store.Clock.Now())
+	if err == models.ErrJobRunChanged {
+		return retried, JobRunnerError{
+			msg: fmt.Sprintf("Job runner: run %v changed while being retried, it is now %v", jr.ID, retried.Status),
+		}
+	} else if err != nil {
+		return jr, wrapError(jr, err)
+	}
+	logger.Infow("Retrying run", retried.ForLogger("retries", retried.Retries)...)
+	return retried, nil
+}
+
+func initiatorHasInput(initr models.Initiator) bool {
+	return initr.IsLogInitiated() || initr.Type == models.InitiatorSpecAndRun
+}
+
 // ExecuteRun calls ExecuteRunAtBlock without an IndexableBlockNumber
 func ExecuteRun(jr models.JobRun, store *store.Store, overrides models.RunResult) (models.JobRun, error) {
 	return ExecuteRunAtBlock(jr, store, overrides, nil)
 }
 
 // ExecuteRunAtBlock starts the job and executes task runs within that job in the
-// order defined in the run for as long as they do not return errors. Results
-// are saved in the store (db).
+// order defined in the run for as long as they do not return errors. Tasks
+// whose parents have all completed are executed concurrently. Results
+// are saved in the store (db). The overrides are merged into the input of
+// the tasks without parents that have not started. Tasks waiting on a bridge
+// are only resumed by ResumeTaskRun.
 func ExecuteRunAtBlock(
 	jr models.JobRun,
 	store *store.Store,
 	overrides models.RunResult,
 	bn *models.IndexableBlockNumber,
+) (models.JobRun, error) {
+	return executeRunAtBlock(jr, store, overrides, bn, -1)
+}
+
+// ResumeTaskRun executes the run after merging the result into the input of
+// the pending TaskRun at index i, such as a bridge reporting its result. The
+// run's other pending TaskRuns keep waiting for their own results.
+func ResumeTaskRun(
+	jr models.JobRun,
+	i int,
+	store *store.Store,
+	result models.RunResult,
+	bn *models.IndexableBlockNumber,
+) (models.JobRun, error) {
+	return executeRunAtBlock(jr, store, result, bn, i)
+}
+
+func executeRunAtBlock(
+	jr models.JobRun,
+	store *store.Store,
+	overrides models.RunResult,
+	bn *models.IndexableBlockNumber,
+	target int,
 ) (models.JobRun, error) {
 	if jr.Status.CanStart() {
 		jr.Status = models.RunStatusInProgress
 	}
-	if err := store.Save(&jr); err != nil {
+	if err := store.SaveJobRun(&jr); err != nil {
 		return jr, wrapError(jr, err)
 	}
 	if jr.Result.HasError() {
@@ -92,40 +319,163 @@ func ExecuteRunAtBlock(
 		return jr, wrapError(jr, err)
 	}
 	logger.Infow("Starting job", jr.ForLogger()...)
-	unfinished := jr.UnfinishedTaskRuns()
-	if len(unfinished) == 0 {
+	if len(jr.UnfinishedTaskRuns()) == 0 {
 		return jr, wrapError(jr, errors.New("No unfinished tasks to run"))
 	}
-	offset := len(jr.TaskRuns) - len(unfinished)
-	latestRun := unfinished[0]
 
-	merged, err := latestRun.Result.Merge(overrides)
-	if err != nil {
-		return jr, wrapError(jr, err)
-	}
-	latestRun.Result = merged
+	started := map[int]bool{}
+	for {
+		ready := []int{}
+		for _, i := range jr.ReadyTaskRuns() {
+			tr := jr.TaskRuns[i]
+			if started[i] || retryPending(tr, store) || (tr.Status.PendingBridge() && i != target) {
+				continue
+			}
+			ready = append(ready, i)
+		}
+		if len(ready) == 0 {
+			break
+		}
 
-	for i, taskRunTemplate := range unfinished {
-		taskRun, err := taskRunTemplate.MergeTaskParams(overrides.Data)
-		if err != nil {
+		taskRuns := make([]models.TaskRun, len(ready))
+		inputs := make([]models.RunResult, len(ready))
+		skips := make([]bool, len(ready))
+		for j, i := range ready {
+			taskRun, err := jr.TaskRuns[i].MergeTaskParams(overrides.Data)
+			if err != nil {
+				return jr, wrapError(jr, err)
+			}
+			input, skip, err := taskRunInput(jr, i, overrides, receivesOverrides(jr, i, target))
+			if err != nil {
+				return jr, wrapError(jr, err)
+			}
+			if !skip && taskRun.Status.Unstarted() {
+				taskRun = taskRun.MarkInProgress(input, store.Clock.Now())
+				jr.TaskRuns[i] = taskRun
+			}
+			taskRuns[j], inputs[j], skips[j] = taskRun, input, skip
+		}
+		if err := store.SaveJobRun(&jr); err != nil {
 			return jr, wrapError(jr, err)
 		}
 
-		latestRun = markCompletedIfRunnable(startTask(jr, taskRun, latestRun.Result, bn, store))
-		jr.TaskRuns[i+offset] = latestRun
-		logTaskResult(latestRun, taskRun, i)
+		results := make([]models.TaskRun, len(ready))
+		var wg sync.WaitGroup
+		for j := range ready {
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				if skips[j] {
+					results[j] = taskRuns[j].MarkSkipped(inputs[j])
+				} else {
+					results[j] = markCompletedIfRunnable(startTask(jr, taskRuns[j], inputs[j], bn, store))
+				}
+			}(j)
+		}
+		wg.Wait()
 
-		if err := store.Save(&jr); err != nil {
+		for j, i := range ready {
+			started[i] = true
+			if results[j].Status.Finished() {
+				results[j].FinishedAt = null.TimeFrom(store.Clock.Now())
+			}
+			jr.TaskRuns[i] = results[j]
+			logTaskResult(results[j], taskRuns[j], i)
+		}
+		if err := store.SaveJobRun(&jr); err != nil {
 			return jr, wrapError(jr, err)
 		}
-		if !latestRun.Status.Runnable() {
+		if jr.LatestTaskRun().Status.Errored() {
 			break
 		}
 	}
 
-	jr = jr.ApplyResult(latestRun.Result)
+	jr = jr.ApplyResult(jr.LatestTaskRun().Result)
 	logger.Infow("Finished current job run execution", jr.ForLogger()...)
-	return jr, wrapError(jr, store.Save(&jr))
+	return jr, wrapError(jr, store.SaveJobRun(&jr))
+}
+
+// taskRunInput builds the input for the TaskRun at index i from the results
+// of its parents, joining them when there are several, and reports whether
+// the task should be skipped. Tasks without parents and tasks being resumed
+// start from their own result, which holds the input they were started with.
+// The overrides are merged into the input if merge is set.
+func taskRunInput(
+	jr models.JobRun,
+	i int,
+	overrides models.RunResult,
+	merge bool,
+) (models.RunResult, bool, error) {
+	tr := jr.TaskRuns[i]
+	parents := jr.TaskRunParents(i)
+	resumed := tr.Status.Pending() || tr.Status.InProgress()
+
+	input := tr.Result
+	if len(parents) > 0 && !resumed {
+		active := []models.TaskRun{}
+		for _, p := range parents {
+			if !jr.TaskRuns[p].Skipped {
+				active = append(active, jr.TaskRuns[p])
+			}
+		}
+		if len(active) == 0 {
+			return jr.TaskRuns[parents[0]].Result, true, nil
+		}
+
+		var err error
+		if input, err = joinResults(active); err != nil {
+			return input, false, err
+		}
+	}
+
+	if merge {
+		merged, err := input.Merge(overrides)
+		if err != nil {
+			return input, false, err
+		}
+		input = merged
+	}
+
+	if tr.Task.Condition == "" || resumed {
+		return input, false, nil
+	}
+	return input, !input.Get(tr.Task.Condition).Bool(), nil
+}
+
+// receivesOverrides returns true if the overrides are meant for the TaskRun
+// at index i: the TaskRun being resumed, or else a TaskRun without parents
+// that has not started, which takes the run's input.
+func receivesOverrides(jr models.JobRun, i int, target int) bool {
+	if target >= 0 {
+		return i == target
+	}
+	return jr.TaskRuns[i].Status.Unstarted() && len(jr.TaskRunParents(i)) == 0
+}
+
+// joinResults merges the results of the given TaskRuns, with later results
+// taking precedence, and adds the value of each one in order under "results".
+func joinResults(trs []models.TaskRun) (models.RunResult, error) {
+	if len(trs) == 1 {
+		return trs[0].Result, nil
+	}
+
+	joined := models.RunResult{}
+	values := []interface{}{}
+	for _, tr := range trs {
+		merged, err := joined.Merge(tr.Result)
+		if err != nil {
+			return joined, err
+		}
+		joined = merged
+		values = append(values, tr.Result.Get("value").Value())
+	}
+
+	data, err := joined.Data.Add("results", values)
+	if err != nil {
+		return joined, err
+	}
+	joined.Data = data
+	return joined, nil
 }
 
 func logTaskResult(lr models.TaskRun, tr models.TaskRun, i int) {
@@ -133,6 +483,10 @@ func logTaskResult(lr models.TaskRun, tr models.TaskRun, i int) {
 	logger.Debugw(fmt.Sprintf("Task %v %v", tr.Task.Type, tr.Result.Status), tr.ForLogger("task", i, "result", lr.Result)...)
 }
 
+func retryPending(tr models.TaskRun, store *store.Store) bool {
+	return tr.Status.PendingRetry() && tr.RetryAt.Time.After(store.Clock.Now())
+}
+
 func markCompletedIfRunnable(tr models.TaskRun) models.TaskRun {
 	if tr.Status.Runnable() {
 		return tr.MarkCompleted()
@@ -151,19 +505,59 @@ func startTask(
 	if err != nil {
 		return tr.ApplyResult(tr.Result.WithError(err))
 	}
+	if bridge, ok := adapter.(*adapters.Bridge); ok {
+		bridge.TaskRunID = tr.ID
+	}
 
-	minConfs := utils.MaxUint64(
-		store.Config.TaskMinConfirmations,
-		tr.Task.Confirmations,
-		adapter.MinConfs())
-
-	if !jr.Runnable(bn, minConfs) {
+	if !jr.Runnable(bn, minConfirmations(tr, adapter, store)) {
 		tr = tr.MarkPendingConfirmations()
 		tr.Result.Data = input.Data
 		return tr
 	}
 
-	return tr.ApplyResult(adapter.Perform(input, store))
+	result := adapter.Perform(input, store)
+	if tr.Task.Retry.ShouldRetry(result, tr.Attempts+1) {
+		retryAt := store.Clock.Now().Add(tr.Task.Retry.Delay(tr.Attempts + 1))
+		logger.Warnw(
+			fmt.Sprintf("Task %v failed, retrying at %v", tr.Task.Type, retryAt),
+			tr.ForLogger("attempts", tr.Attempts+1, "error", result.Error())...,
+		)
+		return tr.MarkPendingRetry(input, retryAt)
+	}
+	return tr.ApplyResult(result)
+}
+
+func minConfirmations(
+	tr models.TaskRun,
+	adapter adapters.AdapterWithMinConfs,
+	store *store.Store,
+) uint64 {
+	return utils.MaxUint64(
+		store.Config.TaskMinConfirmations,
+		tr.Task.Confirmations,
+		adapter.MinConfs())
+}
+
+// confirmationsReached returns true if every task of the run waiting for
+// confirmations has reached its minimum confirmations at the given block.
+func confirmationsReached(
+	jr models.JobRun,
+	bn *models.IndexableBlockNumber,
+	store *store.Store,
+) bool {
+	for _, tr := range jr.TaskRuns {
+		if !tr.Status.PendingConfirmations() {
+			continue
+		}
+		adapter, err := adapters.For(tr.Task, store)
+		if err != nil {
+			return true
+		}
+		if !jr.Runnable(bn, minConfirmations(tr, adapter, store)) {
+			return false
+		}
+	}
+	return true
 }
 
 func wrapError(run models.JobRun, err error) error {
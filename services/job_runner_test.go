@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/tidwall/gjson"
@@ -44,7 +45,7 @@ func TestJobRunner_ExecuteRun(t *testing.T) {
 			var run models.JobRun
 			mockServer, _ := cltest.NewHTTPMockServer(t, 200, "POST", test.runResult,
 				func(body string) {
-					want := fmt.Sprintf(`{"id":"%v","data":%v}`, run.ID, test.input)
+					want := fmt.Sprintf(`{"id":"%v","taskRunId":"%v","data":%v}`, run.ID, run.TaskRuns[0].ID, test.input)
 					assert.JSONEq(t, want, body)
 				})
 			bt := cltest.NewBridgeType(bridgeName, mockServer.URL)
@@ -80,6 +81,118 @@ func TestJobRunner_ExecuteRun(t *testing.T) {
 	}
 }
 
+func TestJobRunner_ResumeTaskRun_ParallelBridges(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	mockServer, _ := cltest.NewHTTPMockServer(t, 200, "POST", `{"pending":true}`)
+	first := cltest.NewBridgeType("firstBridge", mockServer.URL)
+	assert.NoError(t, store.Save(&first))
+	second := cltest.NewBridgeType("secondBridge", mockServer.URL)
+	assert.NoError(t, store.Save(&second))
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask("firstBridge"),
+		cltest.NewTask("secondBridge"),
+		cltest.NewTask("noop"),
+	}
+	job.Tasks[0].ID = "first"
+	job.Tasks[1].ID = "second"
+	job.Tasks[2].Parents = []string{"first", "second"}
+	assert.NoError(t, store.Save(&job))
+
+	run, err := services.ExecuteRun(job.NewRun(initr), store, models.RunResult{})
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingBridge, run.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusPendingBridge, run.TaskRuns[1].Status)
+
+	callback := models.RunResult{Data: cltest.JSONFromString(`{"value":"first"}`), Status: models.RunStatusCompleted}
+	run, err = services.ResumeTaskRun(run, 0, store, callback, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[0].Status)
+	assert.Equal(t, "first", run.TaskRuns[0].Result.Data.Get("value").String())
+	assert.Equal(t, models.RunStatusPendingBridge, run.TaskRuns[1].Status)
+	assert.False(t, run.TaskRuns[1].Result.Data.Get("value").Exists())
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[2].Status)
+	assert.Equal(t, models.RunStatusPendingBridge, run.Status)
+
+	callback = models.RunResult{Data: cltest.JSONFromString(`{"value":"second"}`), Status: models.RunStatusCompleted}
+	run, err = services.ResumeTaskRun(run, 1, store, callback, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.Equal(t, "first", run.TaskRuns[0].Result.Data.Get("value").String())
+	assert.Equal(t, "second", run.TaskRuns[1].Result.Data.Get("value").String())
+	assert.Equal(t, []interface{}{"first", "second"}, run.Result.Data.Get("results").Value())
+}
+
+func TestJobRunner_ExecuteRun_RecordsTaskInputsAndTimings(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask("noop"),
+		cltest.NewTask("noop"),
+	}
+	assert.NoError(t, store.Save(&job))
+
+	input := models.RunResult{Data: cltest.JSONFromString(`{"value":"1"}`)}
+	_, err := services.ExecuteRun(job.NewRun(initr), store, input)
+	assert.NoError(t, err)
+
+	run := cltest.WaitForRuns(t, job, store, 1)[0]
+	for _, tr := range run.TaskRuns {
+		assert.Equal(t, "1", tr.Input.Get("value").String())
+		assert.True(t, tr.StartedAt.Valid)
+		assert.True(t, tr.FinishedAt.Valid)
+		assert.False(t, tr.FinishedAt.Time.Before(tr.StartedAt.Time))
+	}
+}
+
+func TestJobRunner_ExecuteRun_TaskGraph(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	first, firstCleanup := cltest.NewHTTPMockServer(t, 200, "GET", "100")
+	defer firstCleanup()
+	second, secondCleanup := cltest.NewHTTPMockServer(t, 200, "GET", "102")
+	defer secondCleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask("httpget", fmt.Sprintf(`{"url":"%v"}`, first.URL)),
+		cltest.NewTask("httpget", fmt.Sprintf(`{"url":"%v"}`, second.URL)),
+		cltest.NewTask("noop"),
+		cltest.NewTask("noop"),
+	}
+	job.Tasks[0].ID = "first"
+	job.Tasks[1].ID = "second"
+	job.Tasks[2].ID = "conditional"
+	job.Tasks[2].Condition = "enabled"
+	job.Tasks[3].Parents = []string{"first", "second", "conditional"}
+	assert.NoError(t, store.Save(&job))
+
+	run := job.NewRun(initr)
+	input := models.RunResult{Data: cltest.JSONFromString(`{"enabled":false}`)}
+	run, err := services.ExecuteRun(run, store, input)
+	assert.NoError(t, err)
+
+	assert.NoError(t, store.One("ID", run.ID, &run))
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.Equal(t, "100", run.TaskRuns[0].Result.Get("value").String())
+	assert.Equal(t, "102", run.TaskRuns[1].Result.Get("value").String())
+	assert.True(t, run.TaskRuns[2].Skipped)
+	assert.False(t, run.TaskRuns[3].Skipped)
+	assert.JSONEq(t, `["100","102"]`, run.Result.Get("results").Raw)
+}
+
 func TestExecuteRun_TransitionToPendingConfirmations(t *testing.T) {
 	t.Parallel()
 
@@ -166,7 +279,7 @@ func TestExecuteRun_TransitionToPendingConfirmations_WithBridgeTask(t *testing.T
 			run := job.NewRun(initr)
 			mockServer, _ := cltest.NewHTTPMockServer(t, 200, "POST", "{\"todo\": \"todo\"}",
 				func(body string) {
-					want := fmt.Sprintf(`{"id":"%v","data":%v}`, run.ID, "{}")
+					want := fmt.Sprintf(`{"id":"%v","taskRunId":"%v","data":%v}`, run.ID, run.TaskRuns[0].ID, "{}")
 					assert.JSONEq(t, want, body)
 				})
 			bt := cltest.NewBridgeTypeWithDefaultConfirmations(uint64(test.bridgeTypeConfirmations), "randomNumber", mockServer.URL)
@@ -252,6 +365,54 @@ func TestJobRunner_BeginRunWithAmount(t *testing.T) {
 	}
 }
 
+func TestJobRunner_BeginRunWithAmount_JobMinimumPayment(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	store.Config.MinimumContractPayment = *big.NewInt(10)
+	store.Config.AdapterPayments = map[string]*assets.Link{"noop": assets.NewLink(15)}
+
+	bt := cltest.NewBridgeType("priced")
+	bt.MinPayment = assets.NewLink(20)
+	assert.Nil(t, store.Save(&bt))
+
+	tests := []struct {
+		name       string
+		minPayment *assets.Link
+		tasks      []string
+		amount     *big.Int
+		status     models.RunStatus
+	}{
+		{"job minimum met", assets.NewLink(50), []string{"NoOp"}, big.NewInt(50), models.RunStatusCompleted},
+		{"job minimum not met", assets.NewLink(50), []string{"NoOp"}, big.NewInt(49), models.RunStatusErrored},
+		{"job minimum below node minimum", assets.NewLink(5), []string{"NoOp"}, big.NewInt(9), models.RunStatusErrored},
+		{"adapter payments met", nil, []string{"NoOp", "NoOp"}, big.NewInt(30), models.RunStatusCompleted},
+		{"adapter payments not met", nil, []string{"NoOp", "NoOp"}, big.NewInt(29), models.RunStatusErrored},
+		{"bridge payment not met", nil, []string{"NoOp", "priced"}, big.NewInt(34), models.RunStatusErrored},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			job, initr := cltest.NewJobWithWebInitiator()
+			job.MinPayment = test.minPayment
+			job.Tasks = []models.TaskSpec{}
+			for _, taskType := range test.tasks {
+				job.Tasks = append(job.Tasks, cltest.NewTask(taskType))
+			}
+			assert.Nil(t, store.SaveJob(&job))
+
+			run, _ := services.BeginRun(job, initr, models.RunResult{Amount: test.amount}, store)
+			assert.Equal(t, test.status, run.Status)
+
+			saved, err := store.FindJobRun(run.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, test.status, saved.Status)
+		})
+	}
+}
+
 func TestJobRunner_BeginRun(t *testing.T) {
 	pastTime := cltest.ParseNullableTime("2000-01-01T00:00:00.000Z")
 	futureTime := cltest.ParseNullableTime("3000-01-01T00:00:00.000Z")
@@ -301,15 +462,17 @@ func TestJobRunner_BuildRun(t *testing.T) {
 	nullTime := null.Time{Valid: false}
 
 	tests := []struct {
-		name    string
-		startAt null.Time
-		endAt   null.Time
-		errored bool
+		name       string
+		startAt    null.Time
+		endAt      null.Time
+		archivedAt null.Time
+		errored    bool
 	}{
-		{"job not started", futureTime, nullTime, true},
-		{"job started", pastTime, futureTime, false},
-		{"job with no time range", nullTime, nullTime, false},
-		{"job ended", nullTime, pastTime, true},
+		{"job not started", futureTime, nullTime, nullTime, true},
+		{"job started", pastTime, futureTime, nullTime, false},
+		{"job with no time range", nullTime, nullTime, nullTime, false},
+		{"job ended", nullTime, pastTime, nullTime, true},
+		{"job archived", nullTime, nullTime, pastTime, true},
 	}
 
 	store, cleanup := cltest.NewStore()
@@ -323,6 +486,7 @@ func TestJobRunner_BuildRun(t *testing.T) {
 			job, initr := cltest.NewJobWithWebInitiator()
 			job.StartAt = test.startAt
 			job.EndAt = test.endAt
+			job.ArchivedAt = test.archivedAt
 			assert.Nil(t, store.SaveJob(&job))
 
 			_, err := services.BuildRun(job, initr, store)
@@ -335,3 +499,200 @@ func TestJobRunner_BuildRun(t *testing.T) {
 		})
 	}
 }
+
+func TestJobRunner_ResumeUnfinishedRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	input := models.RunResult{Data: cltest.JSONFromString(`{"value":"saved"}`)}
+	webJob, webInitr := cltest.NewJobWithWebInitiator()
+	logJob, logInitr := cltest.NewJobWithLogInitiator()
+	cronJob, cronInitr := cltest.NewJobWithSchedule("* * * * *")
+
+	tests := []struct {
+		name       string
+		job        models.JobSpec
+		initr      models.Initiator
+		taskType   string
+		inProgress bool
+		wantStatus models.RunStatus
+		wantValue  string
+	}{
+		{"in progress", webJob, webInitr, "noop", true, models.RunStatusCompleted, "saved"},
+		{"in progress eth tx", webJob, webInitr, "ethtx", true, models.RunStatusErrored, ""},
+		{"unstarted cron", cronJob, cronInitr, "noop", false, models.RunStatusCompleted, ""},
+		{"unstarted run log", logJob, logInitr, "noop", false, models.RunStatusErrored, ""},
+	}
+
+	runs := []models.JobRun{}
+	for _, test := range tests {
+		job := test.job
+		job.Tasks = []models.TaskSpec{cltest.NewTask(test.taskType)}
+		jr := job.NewRun(test.initr)
+		if test.inProgress {
+			jr.Status = models.RunStatusInProgress
+			jr.TaskRuns[0] = jr.TaskRuns[0].MarkInProgress(input, time.Now())
+		}
+		assert.NoError(t, store.Save(&jr))
+		runs = append(runs, jr)
+	}
+
+	assert.NoError(t, services.ResumeUnfinishedRuns(store, nil))
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jr, err := store.FindJobRun(runs[i].ID)
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantStatus, jr.Status)
+			assert.Equal(t, test.wantValue, jr.Result.Get("value").String())
+		})
+	}
+}
+
+func TestJobRunner_EthTxBroadcast(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 1)
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{{Type: "NoOp"}, {Type: "EthTx"}}
+
+	tests := []struct {
+		name   string
+		status models.RunStatus
+		value  string
+		want   bool
+	}{
+		{"unstarted", models.RunStatusUnstarted, "", false},
+		{"in progress", models.RunStatusInProgress, "", true},
+		{"pending before sending", models.RunStatusPendingConfirmations, "0x01", false},
+		{"pending confirmations", models.RunStatusPendingConfirmations, tx.Hash.String(), true},
+		{"pending retry", models.RunStatusPendingRetry, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jr := j.NewRun(initr)
+			jr.TaskRuns[1].Status = test.status
+			if test.value != "" {
+				jr.TaskRuns[1].Result = models.RunResult{}.WithValue(test.value)
+			}
+
+			sent, err := services.EthTxBroadcast(jr, store)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, sent)
+		})
+	}
+}
+
+func TestJobRunner_CancelRun(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{{Type: "NoOp"}, {Type: "NoOpPend"}}
+	assert.NoError(t, store.SaveJob(&j))
+	jr, err := services.ExecuteRun(j.NewRun(initr), store, models.RunResult{})
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, jr.Status)
+	stale := jr
+
+	jr, err = services.CancelRun(jr, store)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, jr.Status)
+
+	jr = cltest.FindJobRun(store, jr.ID)
+	assert.Equal(t, models.RunStatusCancelled, jr.Status)
+	assert.Equal(t, models.RunStatusCompleted, jr.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusCancelled, jr.TaskRuns[1].Status)
+
+	pending, err := store.JobRunsWithStatus(models.RunStatusPendingConfirmations)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 0)
+
+	// A copy of the run read before it was cancelled is neither executed
+	// nor cancelled again
+	_, err = services.ExecuteRun(stale, store, models.RunResult{})
+	assert.Error(t, err)
+	assert.Equal(t, models.RunStatusCancelled, cltest.FindJobRun(store, jr.ID).Status)
+
+	_, err = services.CancelRun(stale, store)
+	assert.IsType(t, services.JobRunnerError{}, err)
+}
+
+func TestJobRunner_RetryRun(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask("noop"),
+		cltest.NewTask("retriedBridge"),
+		cltest.NewTask("noop"),
+	}
+	assert.NoError(t, store.Save(&job))
+
+	input := models.RunResult{Data: cltest.JSONFromString(`{"value":"input"}`)}
+	run, err := services.ExecuteRun(job.NewRun(initr), store, input)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.Status)
+	assert.Equal(t, models.RunStatusErrored, run.TaskRuns[1].Status)
+
+	mockServer, _ := cltest.NewHTTPMockServer(t, 200, "POST", `{"data":{"value":"100"}}`,
+		func(body string) {
+			assert.Equal(t, "input", gjson.Get(body, "data.value").String())
+		})
+	bt := cltest.NewBridgeType("retriedBridge", mockServer.URL)
+	assert.NoError(t, store.Save(&bt))
+
+	stale := run
+	run, err = services.RetryRun(run, store)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), run.Retries)
+
+	_, err = services.RetryRun(stale, store)
+	assert.IsType(t, services.JobRunnerError{}, err)
+	assert.True(t, run.RetriedAt.Valid)
+	assert.False(t, run.Result.HasError())
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[1].Status)
+
+	run, err = services.ExecuteRun(run, store, models.RunResult{})
+	assert.NoError(t, err)
+	run = cltest.FindJobRun(store, run.ID)
+	assert.Equal(t, models.RunStatusCompleted, run.Status)
+	assert.Equal(t, uint64(1), run.Retries)
+	val, err := run.Result.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "100", val)
+
+	_, err = services.RetryRun(run, store)
+	assert.Error(t, err)
+}
+
+func TestJobRunner_RetryRun_EthTxSent(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 1)
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: "EthTx"}}
+	run := job.NewRun(initr)
+	run = run.MarkErrored(fmt.Errorf("unable to confirm"))
+	run.TaskRuns[0].Result = run.TaskRuns[0].Result.WithValue(tx.Hash.String()).WithError(fmt.Errorf("unable to confirm"))
+	assert.NoError(t, store.Save(&run))
+
+	_, err := services.RetryRun(run, store)
+	assert.Error(t, err)
+	_, ok := err.(services.JobRunnerError)
+	assert.True(t, ok)
+	assert.Equal(t, models.RunStatusErrored, cltest.FindJobRun(store, run.ID).Status)
+}
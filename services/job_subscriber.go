@@ -1,6 +1,7 @@
 package services
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink/logger"
@@ -18,17 +19,67 @@ type JobSubscriber struct {
 }
 
 // AddJob subscribes to ethereum log events for each "runlog" and "ethlog"
-// initiator in the passed job spec.
+// initiator in the passed job spec. The initiators that could be subscribed
+// to are kept listening to even if others could not.
 func (js *JobSubscriber) AddJob(job models.JobSpec, bn *models.IndexableBlockNumber) error {
 	if !job.IsLogInitiated() {
 		return nil
 	}
 
 	sub, err := StartJobSubscription(job, bn, js.Store)
-	if err != nil {
-		return err
+	if len(sub.unsubscribers) > 0 {
+		js.addSubscription(sub)
+	}
+	return err
+}
+
+// RemoveJob unsubscribes the initiators of the job with the given ID.
+func (js *JobSubscriber) RemoveJob(id string) {
+	js.jobsMutex.Lock()
+	defer js.jobsMutex.Unlock()
+	remaining := []JobSubscription{}
+	for _, sub := range js.jobSubscriptions {
+		if sub.Job.ID == id {
+			sub.Unsubscribe()
+		} else {
+			remaining = append(remaining, sub)
+		}
+	}
+	js.jobSubscriptions = remaining
+}
+
+// ReplaceJob subscribes to the initiators of the new version of a job, then
+// swaps its subscriptions for those of the previous versions at once, so
+// that there is no time at which the job is not listened to. The previous
+// subscriptions are kept if the new ones cannot be made. Initiators kept
+// across versions share their LogCheckpoint, so their new subscriptions only
+// handle logs once the previous ones have stopped.
+func (js *JobSubscriber) ReplaceJob(job models.JobSpec, bn *models.IndexableBlockNumber) error {
+	subs := []JobSubscription{}
+	if job.IsLogInitiated() {
+		sub, err := StartJobSubscription(job, bn, js.Store)
+		if err != nil {
+			sub.Unsubscribe()
+			return err
+		}
+		subs = append(subs, sub)
+	}
+
+	js.jobsMutex.Lock()
+	replaced := []JobSubscription{}
+	for _, sub := range js.jobSubscriptions {
+		if sub.Job.ID == job.ID {
+			replaced = append(replaced, sub)
+		} else {
+			subs = append(subs, sub)
+		}
+	}
+	js.jobSubscriptions = subs
+	js.jobsMutex.Unlock()
+
+	for _, sub := range replaced {
+		sub.Unsubscribe()
 	}
-	js.addSubscription(sub)
 	return nil
 }
 
@@ -70,15 +121,73 @@ func (js *JobSubscriber) Disconnect() {
 	js.jobSubscriptions = []JobSubscription{}
 }
 
-// OnNewHead resumes all pending job runs based on the new head activity.
+// OnNewHead resumes the job runs pending confirmations that have reached
+// their minimum confirmations with the new head. Runs waiting on an EthTx
+// transaction are left to the TxConfirmer.
 func (js *JobSubscriber) OnNewHead(head *models.BlockHeader) {
+	pendingRuns, err := js.Store.JobRunsWithStatus(models.RunStatusPendingConfirmations)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	bn := head.ToIndexableBlockNumber()
+	for _, jr := range pendingRuns {
+		if _, ok := awaitedTx(jr, js.Store); ok {
+			continue
+		}
+		if !confirmationsReached(jr, bn, js.Store) {
+			continue
+		}
+		if _, err := ExecuteRunAtBlock(jr, js.Store, models.RunResult{}, bn); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+}
+
+// OnReorg errors the pending log initiated runs created on blocks orphaned
+// by a chain reorganization, releasing their run requests, then resubscribes
+// from the common ancestor so that logs on the new canonical chain are
+// backfilled.
+func (js *JobSubscriber) OnReorg(ancestor *models.IndexableBlockNumber) {
 	pendingRuns, err := js.Store.JobRunsWithStatus(models.RunStatusPendingConfirmations)
 	if err != nil {
 		logger.Error(err.Error())
 	}
 	for _, jr := range pendingRuns {
-		if _, err := ExecuteRunAtBlock(jr, js.Store, jr.Result, head.ToIndexableBlockNumber()); err != nil {
+		if !jr.Initiator.IsLogInitiated() || !jr.Orphaned(ancestor) {
+			continue
+		}
+		err := fmt.Errorf("creation block %v orphaned by chain reorganization", jr.CreationHeight.ToInt())
+		jr = jr.MarkErrored(err)
+		logger.Warnw("Job run orphaned by chain reorganization", jr.ForLogger()...)
+		if err := js.Store.Save(&jr); err != nil {
 			logger.Error(err.Error())
 		}
+		logger.WarnIf(js.Store.DeleteRunRequestFor(jr.ID))
+	}
+
+	js.Disconnect()
+	js.rewindCheckpoints(ancestor)
+	logger.WarnIf(js.Connect(ancestor))
+}
+
+// rewindCheckpoints moves the log checkpoints of the jobs' initiators back
+// to the block after the ancestor, so that the logs of the new canonical
+// chain are handled.
+func (js *JobSubscriber) rewindCheckpoints(ancestor *models.IndexableBlockNumber) {
+	jobs, err := js.Store.Jobs()
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+	next := ancestor.NextInt()
+	if next.Sign() < 0 {
+		next.SetInt64(0)
+	}
+	for _, j := range jobs {
+		for _, initr := range j.Initiators {
+			if initr.IsLogInitiated() {
+				logger.WarnIf(rewindCheckpoint(js.Store, InitiatorCheckpointID(initr), next.Uint64()))
+			}
+		}
 	}
 }
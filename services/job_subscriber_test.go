@@ -3,10 +3,13 @@ package services_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/asdine/storm"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -32,6 +35,83 @@ func TestJobSubscriber_Connect_WithJobs(t *testing.T) {
 	eth.EventuallyAllCalled(t)
 }
 
+func TestJobSubscriber_Connect_SkipsArchivedJobs(t *testing.T) {
+	t.Parallel()
+
+	el, cleanup := cltest.NewJobSubscriber()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(el.Store)
+
+	j1, _ := cltest.NewJobWithLogInitiator()
+	j2, _ := cltest.NewJobWithLogInitiator()
+	assert.Nil(t, el.Store.SaveJob(&j1))
+	assert.Nil(t, el.Store.SaveJob(&j2))
+	_, err := el.Store.ArchiveJob(j1.ID, time.Now())
+	assert.NoError(t, err)
+	eth.RegisterSubscription("logs")
+
+	assert.Nil(t, el.Connect(cltest.IndexableBlockNumber(1)))
+	eth.EventuallyAllCalled(t)
+	assert.Equal(t, []string{j2.ID}, jobIDs(el.Jobs()))
+}
+
+func TestJobSubscriber_RemoveJob(t *testing.T) {
+	t.Parallel()
+
+	el, cleanup := cltest.NewJobSubscriber()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(el.Store)
+
+	j1, _ := cltest.NewJobWithLogInitiator()
+	j2, _ := cltest.NewJobWithLogInitiator()
+	eth.RegisterSubscription("logs")
+	eth.RegisterSubscription("logs")
+	assert.Nil(t, el.AddJob(j1, cltest.IndexableBlockNumber(1)))
+	assert.Nil(t, el.AddJob(j2, cltest.IndexableBlockNumber(1)))
+
+	el.RemoveJob(j1.ID)
+	assert.Equal(t, []string{j2.ID}, jobIDs(el.Jobs()))
+	eth.EventuallyAllCalled(t)
+}
+
+func TestJobSubscriber_ReplaceJob(t *testing.T) {
+	t.Parallel()
+
+	el, cleanup := cltest.NewJobSubscriber()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(el.Store)
+
+	j1, _ := cltest.NewJobWithLogInitiator()
+	j2, _ := cltest.NewJobWithLogInitiator()
+	eth.RegisterSubscription("logs")
+	eth.RegisterSubscription("logs")
+	assert.Nil(t, el.AddJob(j1, cltest.IndexableBlockNumber(1)))
+	assert.Nil(t, el.AddJob(j2, cltest.IndexableBlockNumber(1)))
+
+	update, _ := cltest.NewJobWithLogInitiator()
+	next := j1.NewVersion(update)
+	assert.Error(t, el.ReplaceJob(next, cltest.IndexableBlockNumber(1)))
+	assert.Equal(t, []string{j1.ID, j2.ID}, jobIDs(el.Jobs()))
+
+	eth.RegisterSubscription("logs")
+	assert.Nil(t, el.ReplaceJob(next, cltest.IndexableBlockNumber(1)))
+	assert.Equal(t, []string{j1.ID, j2.ID}, jobIDs(el.Jobs()))
+	assert.Equal(t, uint64(1), el.Jobs()[0].Version)
+
+	web, _ := cltest.NewJobWithWebInitiator()
+	assert.Nil(t, el.ReplaceJob(next.NewVersion(web), cltest.IndexableBlockNumber(1)))
+	assert.Equal(t, []string{j2.ID}, jobIDs(el.Jobs()))
+	eth.EventuallyAllCalled(t)
+}
+
+func jobIDs(jobs []models.JobSpec) []string {
+	ids := []string{}
+	for _, j := range jobs {
+		ids = append(ids, j.ID)
+	}
+	return ids
+}
+
 func newAddr() common.Address {
 	return cltest.NewAddress()
 }
@@ -149,6 +229,86 @@ func TestJobSubscriber_AddJob_Listening(t *testing.T) {
 	}
 }
 
+func TestJobSubscriber_RunLog_RefusesDuplicateRequests(t *testing.T) {
+	t.Parallel()
+
+	el, cleanup := cltest.NewJobSubscriber()
+	defer cleanup()
+	store := el.Store
+
+	eth := cltest.MockEthOnStore(store)
+	logChan := make(chan types.Log, 2)
+	eth.RegisterSubscription("logs", logChan)
+
+	j := cltest.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorRunLog}}
+	el.AddJob(j, cltest.IndexableBlockNumber(1))
+
+	ht := services.NewHeadTracker(store)
+	ht.Attach(el)
+	assert.Nil(t, ht.Start())
+
+	log := cltest.NewRunLog(j.ID, cltest.NewAddress(), 1, `{"value":"100"}`)
+	log.TxHash = cltest.NewHash()
+	duplicate := log
+	duplicate.TxHash = cltest.NewHash()
+	duplicate.Index = 1
+	logChan <- log
+	logChan <- duplicate
+
+	g := gomega.NewGomegaWithT(t)
+	requestID := log.Topics[services.RunLogTopicInternalID].Hex()
+	var rr models.RunRequest
+	g.Eventually(func() string {
+		rr, _ = store.FindRunRequest(requestID)
+		return rr.RunID
+	}).ShouldNot(gomega.BeEmpty())
+	jrs := cltest.WaitForRuns(t, j, store, 1)
+	g.Consistently(func() int {
+		count, err := store.JobRunsCountFor(j.ID)
+		assert.NoError(t, err)
+		return count
+	}).Should(gomega.Equal(1))
+
+	assert.Equal(t, jrs[0].ID, rr.RunID)
+	assert.Equal(t, j.ID, rr.JobID)
+	assert.Equal(t, log.TxHash, rr.TxHash)
+	assert.Equal(t, uint(0), rr.LogIndex)
+	eth.EventuallyAllCalled(t)
+}
+
+func TestJobSubscriber_RunLog_KeepsNoRequestWithoutRun(t *testing.T) {
+	t.Parallel()
+
+	el, cleanup := cltest.NewJobSubscriber()
+	defer cleanup()
+	store := el.Store
+
+	eth := cltest.MockEthOnStore(store)
+	logChan := make(chan types.Log, 1)
+	eth.RegisterSubscription("logs", logChan)
+
+	j := cltest.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorRunLog}}
+	j.EndAt = cltest.NullTime("2000-01-01T00:10:00.000Z")
+	el.AddJob(j, cltest.IndexableBlockNumber(1))
+
+	ht := services.NewHeadTracker(store)
+	ht.Attach(el)
+	assert.Nil(t, ht.Start())
+
+	log := cltest.NewRunLog(j.ID, cltest.NewAddress(), 1, `{"value":"100"}`)
+	logChan <- log
+
+	g := gomega.NewGomegaWithT(t)
+	requestID := log.Topics[services.RunLogTopicInternalID].Hex()
+	g.Consistently(func() error {
+		_, err := store.FindRunRequest(requestID)
+		return err
+	}).Should(gomega.Equal(storm.ErrNotFound))
+	eth.EventuallyAllCalled(t)
+}
+
 func TestJobSubscriber_OnNewHead_OnlyRunPendingConfirmations(t *testing.T) {
 	t.Parallel()
 
@@ -180,3 +340,79 @@ func TestJobSubscriber_OnNewHead_OnlyRunPendingConfirmations(t *testing.T) {
 		})
 	}
 }
+
+func TestJobSubscriber_OnNewHead_ResumesWhenConfirmationsReached(t *testing.T) {
+	t.Parallel()
+
+	el, cleanup := cltest.NewJobSubscriber()
+	defer cleanup()
+	store := el.Store
+
+	job, initr := cltest.NewJobWithLogInitiator()
+	job.Tasks = []models.TaskSpec{{Type: "NoOp", Confirmations: 5}}
+	assert.Nil(t, store.SaveJob(&job))
+
+	run := job.NewRun(initr)
+	run, err := services.ExecuteRunAtBlock(run, store, models.RunResult{}, cltest.IndexableBlockNumber(10))
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, run.Status)
+
+	el.OnNewHead(cltest.NewBlockHeader(12))
+	refreshed, err := store.FindJobRun(run.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConfirmations, refreshed.Status)
+
+	el.OnNewHead(cltest.NewBlockHeader(14))
+	refreshed, err = store.FindJobRun(run.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, refreshed.Status)
+}
+
+func TestJobSubscriber_OnReorg_ErrorsOrphanedRuns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		creationHeight int
+		initr          models.Initiator
+		wantStatus     models.RunStatus
+	}{
+		{"orphaned runlog", 11, models.Initiator{Type: models.InitiatorRunLog}, models.RunStatusErrored},
+		{"canonical runlog", 10, models.Initiator{Type: models.InitiatorRunLog}, models.RunStatusPendingConfirmations},
+		{"orphaned web", 11, models.Initiator{Type: models.InitiatorWeb}, models.RunStatusPendingConfirmations},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			el, cleanup := cltest.NewJobSubscriber()
+			defer cleanup()
+			store := el.Store
+
+			job := cltest.NewJob()
+			job.Initiators = []models.Initiator{test.initr}
+			assert.Nil(t, store.SaveJob(&job))
+			run := job.NewRun(job.Initiators[0])
+			run.Status = models.RunStatusPendingConfirmations
+			run.TaskRuns[0] = run.TaskRuns[0].MarkPendingConfirmations()
+			height := cltest.BigHexInt(test.creationHeight)
+			run.CreationHeight = &height
+			assert.Nil(t, store.Save(&run))
+			rr := models.RunRequest{ID: cltest.NewHash().Hex(), JobID: job.ID, RunID: run.ID}
+			assert.Nil(t, store.Save(&rr))
+
+			eth := cltest.MockEthOnStore(store)
+			if test.initr.IsLogInitiated() {
+				eth.RegisterSubscription("logs")
+			}
+			el.OnReorg(cltest.IndexableBlockNumber(10))
+
+			refreshed, err := store.FindJobRun(run.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantStatus, refreshed.Status)
+			assert.Equal(t, test.wantStatus, refreshed.TaskRuns[0].Status)
+			_, err = store.FindRunRequest(rr.ID)
+			assert.Equal(t, test.wantStatus.Errored(), err == storm.ErrNotFound)
+			eth.EventuallyAllCalled(t)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// retrierInterval is how often the Retrier checks for runs due a retry.
+const retrierInterval = time.Second
+
+// Retrier resumes runs that are waiting to retry a failed task once the
+// task's backoff has elapsed. Waiting runs are read from the store, so they
+// are retried after the node restarts. Runs resume at the HeadTracker's
+// latest block so that tasks still honour their confirmations.
+type Retrier struct {
+	store       *store.Store
+	headTracker *HeadTracker
+	done        chan struct{}
+	wg          sync.WaitGroup
+}
+
+// NewRetrier returns a Retrier for runs in the given store.
+func NewRetrier(store *store.Store, headTracker *HeadTracker) *Retrier {
+	return &Retrier{store: store, headTracker: headTracker}
+}
+
+// Start begins periodically resuming runs whose retry is due.
+func (r *Retrier) Start() error {
+	if r.done != nil {
+		return errors.New("Retrier already started")
+	}
+	r.done = make(chan struct{})
+	r.wg.Add(1)
+	go r.listen()
+	return nil
+}
+
+// Stop stops the Retrier and waits for any retries in progress.
+func (r *Retrier) Stop() {
+	if r.done != nil {
+		close(r.done)
+		r.wg.Wait()
+		r.done = nil
+	}
+}
+
+func (r *Retrier) listen() {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-r.store.Clock.After(retrierInterval):
+			if err := r.ResumeDue(); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+	}
+}
+
+// ResumeDue executes every run with a task waiting for a retry whose time
+// has come.
+func (r *Retrier) ResumeDue() error {
+	pendingRuns, err := r.store.JobRunsWithStatus(models.RunStatusPendingRetry)
+	if err != nil {
+		return err
+	}
+	now := r.store.Clock.Now()
+	bn := r.headTracker.LastRecord()
+	for _, jr := range pendingRuns {
+		if !retryDue(jr, now) {
+			continue
+		}
+		if _, err := ExecuteRunAtBlock(jr, r.store, models.RunResult{}, bn); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+	return nil
+}
+
+func retryDue(jr models.JobRun, now time.Time) bool {
+	for _, tr := range jr.TaskRuns {
+		if tr.Status.PendingRetry() && !tr.RetryAt.Time.After(now) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package services_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetrier_ResumeDue(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	clock := cltest.UseSettableClock(store)
+	now := time.Now()
+	clock.SetTime(now)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("100"))
+	}))
+	defer server.Close()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	task := cltest.NewTask("httpget", `{"url":"`+server.URL+`"}`)
+	task.Retry = &models.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     models.Duration{Duration: time.Minute},
+	}
+	job.Tasks = []models.TaskSpec{task}
+	assert.NoError(t, store.SaveJob(&job))
+
+	jr, err := services.ExecuteRun(job.NewRun(initr), store, models.RunResult{})
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingRetry, jr.Status)
+	assert.Equal(t, uint64(1), jr.TaskRuns[0].Attempts)
+	assert.Equal(t, now.Add(time.Minute).Unix(), jr.TaskRuns[0].RetryAt.Time.Unix())
+
+	retrier := services.NewRetrier(store, services.NewHeadTracker(store))
+	assert.NoError(t, retrier.ResumeDue())
+	assert.NoError(t, store.One("ID", jr.ID, &jr))
+	assert.Equal(t, models.RunStatusPendingRetry, jr.Status)
+	assert.Equal(t, 1, requests)
+
+	clock.SetTime(now.Add(time.Minute))
+	assert.NoError(t, retrier.ResumeDue())
+	assert.NoError(t, store.One("ID", jr.ID, &jr))
+	assert.Equal(t, models.RunStatusCompleted, jr.Status)
+	assert.Equal(t, "100", jr.Result.Get("value").String())
+	assert.Equal(t, 2, requests)
+}
+
+func TestRetrier_ResumeDue_ExhaustsAttempts(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	clock := cltest.UseSettableClock(store)
+	clock.SetTime(time.Now())
+
+	server, serverCleanup := cltest.NewHTTPMockServer(t, 503, "GET", "unavailable")
+	defer serverCleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	task := cltest.NewTask("httpget", `{"url":"`+server.URL+`"}`)
+	task.Retry = &models.RetryPolicy{MaxAttempts: 2}
+	job.Tasks = []models.TaskSpec{task}
+	assert.NoError(t, store.SaveJob(&job))
+
+	jr, err := services.ExecuteRun(job.NewRun(initr), store, models.RunResult{})
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingRetry, jr.Status)
+
+	assert.NoError(t, services.NewRetrier(store, services.NewHeadTracker(store)).ResumeDue())
+	assert.NoError(t, store.One("ID", jr.ID, &jr))
+	assert.Equal(t, models.RunStatusErrored, jr.Status)
+	assert.Equal(t, "unavailable", jr.Result.Error())
+}
+
+func TestRetrier_ResumeDue_RespectsConfirmations(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	clock := cltest.UseSettableClock(store)
+	now := time.Now()
+	clock.SetTime(now)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("100"))
+	}))
+	defer server.Close()
+
+	job, initr := cltest.NewJobWithLogInitiator()
+	task := cltest.NewTask("httpget", `{"url":"`+server.URL+`"}`)
+	task.Retry = &models.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     models.Duration{Duration: time.Minute},
+	}
+	job.Tasks = []models.TaskSpec{task, {Type: "NoOp", Confirmations: 10}}
+	assert.NoError(t, store.SaveJob(&job))
+
+	creationHeight := cltest.IndexableBlockNumber(1000)
+	jr, err := store.SaveCreationHeight(job.NewRun(initr), creationHeight)
+	assert.NoError(t, err)
+	jr, err = services.ExecuteRunAtBlock(jr, store, models.RunResult{}, creationHeight)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingRetry, jr.Status)
+
+	ht := services.NewHeadTracker(store)
+	assert.NoError(t, ht.Save(creationHeight))
+
+	clock.SetTime(now.Add(time.Minute))
+	assert.NoError(t, services.NewRetrier(store, ht).ResumeDue())
+	assert.NoError(t, store.One("ID", jr.ID, &jr))
+	assert.Equal(t, models.RunStatusPendingConfirmations, jr.Status)
+	assert.Equal(t, models.RunStatusCompleted, jr.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusPendingConfirmations, jr.TaskRuns[1].Status)
+	assert.Equal(t, 2, requests)
+}
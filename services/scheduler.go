@@ -94,20 +94,29 @@ func (s *Scheduler) AddJob(job models.JobSpec) {
 	s.addJob(job)
 }
 
+// RemoveJob stops the schedules of the job with the given ID.
+func (s *Scheduler) RemoveJob(id string) {
+	s.Recurring.RemoveJob(id)
+	s.OneTime.RemoveJob(id)
+}
+
 // Recurring is used for runs that need to execute on a schedule,
 // and is configured with cron.
 // Instances of Recurring must be initialized using NewRecurring().
 type Recurring struct {
-	Cron  Cron
-	Clock Nower
-	store *store.Store
+	Cron          Cron
+	Clock         Nower
+	store         *store.Store
+	versions      map[string]uint64
+	versionsMutex sync.RWMutex
 }
 
 // NewRecurring create a new instance of Recurring, ready to use.
 func NewRecurring(store *store.Store) *Recurring {
 	return &Recurring{
-		store: store,
-		Clock: store.Clock,
+		store:    store,
+		Clock:    store.Clock,
+		versions: map[string]uint64{},
 	}
 }
 
@@ -125,12 +134,20 @@ func (r *Recurring) Stop() {
 }
 
 // AddJob looks for "cron" initiators, adds them to cron's schedule
-// for execution when specified.
+// for execution when specified. The schedules of the job's previous
+// versions are stopped.
 func (r *Recurring) AddJob(job models.JobSpec) {
+	r.versionsMutex.Lock()
+	r.versions[job.ID] = job.Version
+	r.versionsMutex.Unlock()
+
 	for _, i := range job.InitiatorsFor(models.InitiatorCron) {
 		initr := i
 		if !job.Ended(r.Clock.Now()) {
 			r.Cron.AddFunc(string(initr.Schedule), func() {
+				if !r.isCurrent(job) {
+					return
+				}
 				_, err := BeginRun(job, initr, models.RunResult{}, r.store)
 				if err != nil && !expectedRecurringError(err) {
 					logger.Error(err.Error())
@@ -140,11 +157,30 @@ func (r *Recurring) AddJob(job models.JobSpec) {
 	}
 }
 
+// RemoveJob stops running the job with the given ID on its schedules. As
+// cron entries cannot be removed, they are skipped from then on.
+func (r *Recurring) RemoveJob(id string) {
+	r.versionsMutex.Lock()
+	defer r.versionsMutex.Unlock()
+	delete(r.versions, id)
+}
+
+// isCurrent returns true if the job has not been removed or replaced by
+// another version.
+func (r *Recurring) isCurrent(job models.JobSpec) bool {
+	r.versionsMutex.RLock()
+	defer r.versionsMutex.RUnlock()
+	version, ok := r.versions[job.ID]
+	return ok && version == job.Version
+}
+
 // OneTime represents runs that are to be executed only once.
 type OneTime struct {
-	Store *store.Store
-	Clock Afterer
-	done  chan struct{}
+	Store     *store.Store
+	Clock     Afterer
+	done      chan struct{}
+	jobs      map[string]chan struct{}
+	jobsMutex sync.Mutex
 }
 
 // Start allocates a channel for the "done" field with an empty struct.
@@ -153,10 +189,34 @@ func (ot *OneTime) Start() error {
 	return nil
 }
 
-// AddJob runs the job at the time specified for the "runat" initiator.
+// AddJob runs the job at the time specified for the "runat" initiator,
+// cancelling the pending runs of the job's previous versions.
 func (ot *OneTime) AddJob(job models.JobSpec) {
-	for _, initr := range job.InitiatorsFor(models.InitiatorRunAt) {
-		go ot.RunJobAt(initr, job)
+	ot.RemoveJob(job.ID)
+	initrs := job.InitiatorsFor(models.InitiatorRunAt)
+	if len(initrs) == 0 {
+		return
+	}
+
+	removed := make(chan struct{})
+	ot.jobsMutex.Lock()
+	if ot.jobs == nil {
+		ot.jobs = map[string]chan struct{}{}
+	}
+	ot.jobs[job.ID] = removed
+	ot.jobsMutex.Unlock()
+	for _, initr := range initrs {
+		go ot.runJobAt(initr, job, removed)
+	}
+}
+
+// RemoveJob cancels the pending runs of the job with the given ID.
+func (ot *OneTime) RemoveJob(id string) {
+	ot.jobsMutex.Lock()
+	defer ot.jobsMutex.Unlock()
+	if removed, ok := ot.jobs[id]; ok {
+		close(removed)
+		delete(ot.jobs, id)
 	}
 }
 
@@ -168,8 +228,13 @@ func (ot *OneTime) Stop() {
 // RunJobAt wait until the Stop() function has been called on the run
 // or the specified time for the run is after the present time.
 func (ot *OneTime) RunJobAt(initr models.Initiator, job models.JobSpec) {
+	ot.runJobAt(initr, job, nil)
+}
+
+func (ot *OneTime) runJobAt(initr models.Initiator, job models.JobSpec, removed chan struct{}) {
 	select {
 	case <-ot.done:
+	case <-removed:
 	case <-ot.Clock.After(initr.Time.DurationFromNow()):
 		if err := ot.Store.MarkRan(&initr); err != nil {
 			logger.Error(err.Error())
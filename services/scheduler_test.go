@@ -48,6 +48,63 @@ func TestScheduler_AddJob_WhenStopped(t *testing.T) {
 	cltest.WaitForRuns(t, j, store, 0)
 }
 
+func TestScheduler_RemoveJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	sched := services.NewScheduler(store)
+	cron := cltest.NewMockCron()
+	sched.Recurring.Cron = cron
+	clock := cltest.NewTriggerClock()
+	sched.OneTime.Clock = clock
+	assert.Nil(t, sched.OneTime.Start())
+	defer sched.OneTime.Stop()
+
+	cronJob, _ := cltest.NewJobWithSchedule("* * * * *")
+	assert.Nil(t, store.SaveJob(&cronJob))
+	runAtJob, _ := cltest.NewJobWithRunAtInitiator(time.Now().Add(time.Hour))
+	assert.Nil(t, store.SaveJob(&runAtJob))
+	keptJob, _ := cltest.NewJobWithRunAtInitiator(time.Now().Add(time.Hour))
+	assert.Nil(t, store.SaveJob(&keptJob))
+	sched.Recurring.AddJob(cronJob)
+	sched.OneTime.AddJob(runAtJob)
+	sched.OneTime.AddJob(keptJob)
+
+	sched.RemoveJob(cronJob.ID)
+	sched.RemoveJob(runAtJob.ID)
+	cron.RunEntries()
+	clock.Trigger()
+
+	cltest.WaitForRuns(t, keptJob, store, 1)
+	cltest.WaitForRuns(t, cronJob, store, 0)
+	cltest.WaitForRuns(t, runAtJob, store, 0)
+}
+
+func TestRecurring_AddJob_ReplacesPreviousVersion(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	r := services.NewRecurring(store)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+
+	job, _ := cltest.NewJobWithSchedule("* * * * *")
+	assert.Nil(t, store.SaveJob(&job))
+	r.AddJob(job)
+
+	update, _ := cltest.NewJobWithSchedule("*/2 * * * *")
+	next := job.NewVersion(update)
+	assert.Nil(t, store.UpdateJob(job, &next))
+	r.AddJob(next)
+	assert.Len(t, cron.Entries, 2)
+
+	cron.RunEntries()
+	runs := cltest.WaitForRuns(t, job, store, 1)
+	assert.Equal(t, uint64(1), runs[0].JobVersion)
+}
+
 func TestScheduler_Start_AddingUnstartedJob(t *testing.T) {
 	logs := cltest.ObserveLogs()
 
@@ -33,6 +33,10 @@ const (
 // See https://github.com/smartcontractkit/chainlink/blob/master/solidity/contracts/Oracle.sol
 var SpecAndRunTopic = common.HexToHash("0x40a86f3bd301164dcd67d63d081ecb2db540ac73bafb27eea27d65b3a2694f39")
 
+// specAndRunCheckpointID is the ID of the LogCheckpoint of the SpecAndRun
+// logs handled.
+const specAndRunCheckpointID = "spec_and_run"
+
 // SpecAndRunSubscriber listens to push notifications from the ethereum node
 // for JobSpec and Run requests originating on chain.
 type SpecAndRunSubscriber struct {
@@ -61,7 +65,7 @@ func (scl *SpecAndRunSubscriber) Connect(head *models.IndexableBlockNumber) erro
 		log.Info("Only accepting SpecAndRun requests from contract at: %s", scl.listenAddress.String())
 		filter.Addresses = []common.Address{*scl.listenAddress}
 	}
-	sub, err := NewManagedSubscription(scl.store, filter, scl.dispatchLog)
+	sub, err := NewManagedSubscription(scl.store, specAndRunCheckpointID, filter, scl.dispatchLog)
 	scl.subscription = sub
 	return err
 }
@@ -70,6 +74,10 @@ func (scl *SpecAndRunSubscriber) Connect(head *models.IndexableBlockNumber) erro
 // is handled by JobSubscriber, regardless of Initiator.
 func (scl *SpecAndRunSubscriber) OnNewHead(*models.BlockHeader) {}
 
+// OnReorg is a noop. Each SpecAndRun log creates a new job, so backfilling
+// them again would duplicate jobs rather than recover orphaned runs.
+func (scl *SpecAndRunSubscriber) OnReorg(*models.IndexableBlockNumber) {}
+
 // Disconnect unsubscribes the SpecAndRunTopic subscription, stopping new jobs
 // from on-chain.
 func (scl *SpecAndRunSubscriber) Disconnect() {
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
+	"github.com/asdine/storm"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -26,6 +28,10 @@ const (
 	RunLogTopicAmount
 )
 
+// logBackfillBlockRange is the number of blocks whose logs are requested at a
+// time when backfilling.
+const logBackfillBlockRange uint64 = 1000
+
 // RunLogTopic is the signature for the RunRequest(...) event
 // which Chainlink RunLog initiators watch for.
 // See https://github.com/smartcontractkit/chainlink/blob/master/solidity/contracts/Oracle.sol
@@ -122,7 +128,7 @@ func NewInitiatorSubscription(
 		callback:  callback,
 	}
 
-	managedSub, err := NewManagedSubscription(store, filter, sub.dispatchLog)
+	managedSub, err := NewManagedSubscription(store, InitiatorCheckpointID(initr), filter, sub.dispatchLog)
 	if err != nil {
 		return sub, err
 	}
@@ -132,6 +138,12 @@ func NewInitiatorSubscription(
 	return sub, nil
 }
 
+// InitiatorCheckpointID returns the ID of the LogCheckpoint of the logs
+// handled for the initiator.
+func InitiatorCheckpointID(initr models.Initiator) string {
+	return fmt.Sprintf("initiator/%v/%v", initr.JobID, initr.ID)
+}
+
 func (sub InitiatorSubscription) dispatchLog(log types.Log) {
 	sub.callback(InitiatorSubscriptionLogEvent{
 		Job:       sub.Job,
@@ -185,7 +197,23 @@ func receiveRunLog(le InitiatorSubscriptionLogEvent) {
 		return
 	}
 
-	runJob(le, data, le.Initiator)
+	rr := le.RunRequest()
+	if _, err := le.store.FindRunRequest(rr.ID); err == nil {
+		logger.Warnw(fmt.Sprintf("Skipping duplicate run request %v", rr.ID), le.ForLogger()...)
+		return
+	} else if err != storm.ErrNotFound {
+		logger.Errorw(err.Error(), le.ForLogger()...)
+		return
+	}
+
+	jr := runJob(le, data, le.Initiator)
+	if jr.ID == "" {
+		return
+	}
+	rr.RunID = jr.ID
+	if err := le.store.Save(&rr); err != nil {
+		logger.Errorw(err.Error(), le.ForLogger()...)
+	}
 }
 
 // Parse the log and run the job specific to this initiator log event.
@@ -200,38 +228,82 @@ func receiveEthLog(le InitiatorSubscriptionLogEvent) {
 	runJob(le, data, le.Initiator)
 }
 
-func runJob(le InitiatorSubscriptionLogEvent, data models.JSON, initr models.Initiator) {
+// runJob begins a run of the job with the log's data, returning the run if
+// one was created.
+func runJob(le InitiatorSubscriptionLogEvent, data models.JSON, initr models.Initiator) models.JobRun {
 	payment, err := le.ContractPayment()
 	if err != nil {
 		logger.Errorw(err.Error(), le.ForLogger()...)
-		return
+		return models.JobRun{}
 	}
 	input := models.RunResult{
 		Data:   data,
 		Amount: payment,
 	}
-	if _, err := BeginRunAtBlock(le.Job, initr, input, le.store, le.ToIndexableBlockNumber()); err != nil {
+	jr, err := BeginRunAtBlock(le.Job, initr, input, le.store, le.ToIndexableBlockNumber())
+	if err != nil {
 		logger.Errorw(err.Error(), le.ForLogger()...)
 	}
+	return jr
+}
+
+// checkpointLocks holds a lock for each LogCheckpoint in use, so that of the
+// subscriptions sharing a checkpoint, such as those of a job's versions, only
+// one handles logs at a time.
+var checkpointLocks = struct {
+	sync.Mutex
+	locks map[string]*sync.Mutex
+}{locks: map[string]*sync.Mutex{}}
+
+func checkpointLock(id string) *sync.Mutex {
+	checkpointLocks.Lock()
+	defer checkpointLocks.Unlock()
+	lock, ok := checkpointLocks.locks[id]
+	if !ok {
+		lock = &sync.Mutex{}
+		checkpointLocks.locks[id] = lock
+	}
+	return lock
+}
+
+// rewindCheckpoint rewinds the LogCheckpoint with the given ID once the
+// subscriptions using it have stopped, so that they do not save over it.
+func rewindCheckpoint(store *store.Store, id string, blockNumber uint64) error {
+	lock := checkpointLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+	return store.RewindLogCheckpoint(id, blockNumber)
 }
 
 // ManagedSubscription encapsulates the connecting, backfilling, and clean up of an
-// ethereum node subscription.
+// ethereum node subscription. The logs handled are recorded in a
+// LogCheckpoint, so that none is handled twice or skipped across restarts.
+// A subscription only starts handling logs once any other subscription using
+// its checkpoint has been unsubscribed, resuming from where that one stopped.
 type ManagedSubscription struct {
 	store           *store.Store
 	logs            chan types.Log
 	errors          chan error
 	ethSubscription models.EthSubscription
+	checkpoint      *models.LogCheckpoint
 	callback        func(types.Log)
+	unsubscribed    chan struct{}
 }
 
 // NewManagedSubscription subscribes to the ethereum node with the passed filter
-// and delegates incoming logs to callback.
+// and delegates incoming logs to callback, resuming from the checkpoint with
+// the given ID.
 func NewManagedSubscription(
 	store *store.Store,
+	checkpointID string,
 	filter ethereum.FilterQuery,
 	callback func(types.Log),
 ) (*ManagedSubscription, error) {
+	checkpoint, err := store.FindLogCheckpoint(checkpointID)
+	if err != nil {
+		return nil, err
+	}
+
 	logs := make(chan types.Log)
 	es, err := store.TxManager.SubscribeToLogs(logs, filter)
 	if err != nil {
@@ -243,7 +315,9 @@ func NewManagedSubscription(
 		callback:        callback,
 		logs:            logs,
 		ethSubscription: es,
+		checkpoint:      checkpoint,
 		errors:          make(chan error),
+		unsubscribed:    make(chan struct{}),
 	}
 	go sub.listenToSubscriptionErrors()
 	go sub.listenToLogs(filter)
@@ -255,6 +329,7 @@ func (sub ManagedSubscription) Unsubscribe() {
 	if sub.ethSubscription != nil {
 		sub.ethSubscription.Unsubscribe()
 	}
+	close(sub.unsubscribed)
 	close(sub.logs)
 	close(sub.errors)
 }
@@ -266,31 +341,82 @@ func (sub ManagedSubscription) listenToSubscriptionErrors() {
 }
 
 func (sub ManagedSubscription) listenToLogs(q ethereum.FilterQuery) {
-	backfilledSet := sub.backfillLogs(q)
+	lock := checkpointLock(sub.checkpoint.ID)
+	lock.Lock()
+	defer lock.Unlock()
+	select {
+	case <-sub.unsubscribed:
+		return
+	default:
+	}
+
+	checkpoint, err := sub.store.FindLogCheckpoint(sub.checkpoint.ID)
+	if err != nil {
+		logger.Errorw("Unable to reload log checkpoint", "err", err, "checkpoint", sub.checkpoint.ID)
+	} else {
+		*sub.checkpoint = *checkpoint
+	}
+
+	sub.backfillLogs(q)
 	for log := range sub.logs {
-		if _, present := backfilledSet[log.BlockHash.String()]; !present {
-			sub.callback(log)
-		}
+		sub.handleLog(log)
+		sub.checkpoint.Advance(log.BlockNumber)
+		sub.saveCheckpoint()
 	}
 }
 
-func (sub ManagedSubscription) backfillLogs(q ethereum.FilterQuery) map[string]bool {
-	backfilledSet := map[string]bool{}
-	if q.FromBlock.Cmp(big.NewInt(0)) <= 0 {
-		return backfilledSet
+// backfillLogs handles the logs from the checkpoint, or from the filter's
+// FromBlock for a new checkpoint, up to the latest block. Logs are requested
+// logBackfillBlockRange blocks at a time, moving the checkpoint forward after
+// each range.
+func (sub ManagedSubscription) backfillLogs(q ethereum.FilterQuery) {
+	from := sub.checkpoint.BlockNumber
+	if from == 0 && q.FromBlock != nil && q.FromBlock.Sign() > 0 {
+		from = q.FromBlock.Uint64()
+	}
+	if from == 0 {
+		return
 	}
 
-	logs, err := sub.store.TxManager.GetLogs(q)
+	latest, err := sub.store.TxManager.GetBlockNumber()
 	if err != nil {
 		logger.Errorw("Unable to backfill logs", "err", err)
-		return backfilledSet
+		return
+	}
+	for start := from; start <= latest; start += logBackfillBlockRange {
+		end := start + logBackfillBlockRange - 1
+		if end > latest {
+			end = latest
+		}
+		rq := q
+		rq.FromBlock = new(big.Int).SetUint64(start)
+		rq.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := sub.store.TxManager.GetLogs(rq)
+		if err != nil {
+			logger.Errorw("Unable to backfill logs", "err", err, "fromBlock", start, "toBlock", end)
+			return
+		}
+
+		for _, log := range logs {
+			sub.handleLog(log)
+		}
+		sub.checkpoint.Advance(end + 1)
+		sub.saveCheckpoint()
+	}
+}
+
+func (sub ManagedSubscription) handleLog(log types.Log) {
+	if sub.checkpoint.IsHandled(log) {
+		return
 	}
+	sub.callback(log)
+	sub.checkpoint.MarkHandled(log)
+}
 
-	for _, log := range logs {
-		backfilledSet[log.BlockHash.String()] = true
-		sub.callback(log)
+func (sub ManagedSubscription) saveCheckpoint() {
+	if err := sub.store.Save(sub.checkpoint); err != nil {
+		logger.Errorw("Unable to save log checkpoint", "err", err, "checkpoint", sub.checkpoint.ID)
 	}
-	return backfilledSet
 }
 
 // InitiatorSubscriptionLogEvent encapsulates all information as a result of a received log from an
@@ -390,6 +516,19 @@ func (le InitiatorSubscriptionLogEvent) EthLogJSON() (models.JSON, error) {
 	return out, json.Unmarshal(b, &out)
 }
 
+// RunRequest returns the RunRequest made by the contained RunLog, identified
+// by the request ID in its topics.
+func (le InitiatorSubscriptionLogEvent) RunRequest() models.RunRequest {
+	return models.RunRequest{
+		ID:          le.Log.Topics[RunLogTopicInternalID].Hex(),
+		JobID:       le.Job.ID,
+		TxHash:      le.Log.TxHash,
+		LogIndex:    le.Log.Index,
+		BlockNumber: le.Log.BlockNumber,
+		CreatedAt:   le.store.Clock.Now(),
+	}
+}
+
 // ContractPayment returns the amount attached to a contract to pay the Oracle upon fulfillment.
 func (le InitiatorSubscriptionLogEvent) ContractPayment() (*big.Int, error) {
 	if !isRunLog(le.Log) {
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/services"
 	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -73,6 +74,7 @@ func TestServices_NewInitiatorSubscription_BackfillLogs(t *testing.T) {
 
 	job, initr := cltest.NewJobWithLogInitiator()
 	log := cltest.LogFromFixture("../internal/fixtures/eth/subscription_logs.json")
+	eth.Register("eth_blockNumber", utils.Uint64ToHex(100))
 	eth.Register("eth_getLogs", []types.Log{log})
 	eth.RegisterSubscription("logs")
 
@@ -118,6 +120,7 @@ func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T)
 
 	job, initr := cltest.NewJobWithLogInitiator()
 	log := cltest.LogFromFixture("../internal/fixtures/eth/subscription_logs.json")
+	eth.Register("eth_blockNumber", utils.Uint64ToHex(100))
 	eth.Register("eth_getLogs", []types.Log{log}) // backfill
 	logsChan := make(chan types.Log)
 	eth.RegisterSubscription("logs", logsChan)
@@ -134,6 +137,7 @@ func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T)
 	logsChan <- log
 	// Add a log after the repeated log to make sure it gets processed
 	log2 := cltest.LogFromFixture("../internal/fixtures/eth/subscription_logs_hello_world.json")
+	log2.BlockNumber = log.BlockNumber + 1
 	logsChan <- log2
 
 	eth.EventuallyAllCalled(t)
@@ -141,6 +145,131 @@ func TestServices_NewInitiatorSubscription_PreventsDoubleDispatch(t *testing.T)
 	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
 }
 
+func TestServices_NewInitiatorSubscription_SkipsBackfilledLogsReceivedLive(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+
+	job, initr := cltest.NewJobWithLogInitiator()
+	backfilled := types.Log{TxHash: cltest.NewHash(), Index: 0, BlockNumber: 50}
+	eth.Register("eth_blockNumber", utils.Uint64ToHex(100))
+	eth.Register("eth_getLogs", []types.Log{backfilled})
+	logsChan := make(chan types.Log)
+	eth.RegisterSubscription("logs", logsChan)
+
+	var count int32
+	callback := func(services.InitiatorSubscriptionLogEvent) { atomic.AddInt32(&count, 1) }
+	filter := services.NewInitiatorFilterQuery(initr, cltest.IndexableBlockNumber(0), nil)
+	sub, err := services.NewInitiatorSubscription(initr, job, store, filter, callback)
+	assert.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// The live subscription also delivers the log found by the backfill
+	logsChan <- backfilled
+	logsChan <- types.Log{TxHash: cltest.NewHash(), Index: 0, BlockNumber: 101}
+
+	eth.EventuallyAllCalled(t)
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
+	g.Consistently(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
+}
+
+func TestServices_NewInitiatorSubscription_WaitsForSubscriptionSharingCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+
+	job, initr := cltest.NewJobWithLogInitiator()
+	eth.Register("eth_blockNumber", utils.Uint64ToHex(100))
+	eth.Register("eth_getLogs", []types.Log{})
+	eth.Register("eth_blockNumber", utils.Uint64ToHex(100))
+	previousLogs := make(chan types.Log)
+	eth.RegisterSubscription("logs", previousLogs)
+	nextLogs := make(chan types.Log)
+	eth.RegisterSubscription("logs", nextLogs)
+
+	var count int32
+	callback := func(services.InitiatorSubscriptionLogEvent) { atomic.AddInt32(&count, 1) }
+	filter := services.NewInitiatorFilterQuery(initr, cltest.IndexableBlockNumber(0), nil)
+	previous, err := services.NewInitiatorSubscription(initr, job, store, filter, callback)
+	assert.NoError(t, err)
+
+	handled := types.Log{TxHash: cltest.NewHash(), Index: 0, BlockNumber: 101}
+	previousLogs <- handled
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(1)))
+
+	next, err := services.NewInitiatorSubscription(initr, job, store, filter, callback)
+	assert.NoError(t, err)
+	defer next.Unsubscribe()
+
+	// The next subscription waits for the previous one to stop before
+	// handling any log, then skips those the previous one handled
+	go func() {
+		nextLogs <- handled
+		nextLogs <- types.Log{TxHash: cltest.NewHash(), Index: 0, BlockNumber: 102}
+	}()
+	g.Consistently(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(1)))
+
+	previous.Unsubscribe()
+	g.Eventually(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
+	g.Consistently(func() int32 { return atomic.LoadInt32(&count) }).Should(gomega.Equal(int32(2)))
+	eth.EventuallyAllCalled(t)
+}
+
+func TestServices_NewInitiatorSubscription_BackfillsInRangesFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	eth := cltest.MockEthOnStore(store)
+
+	job, initr := cltest.NewJobWithLogInitiator()
+	handled := types.Log{TxHash: cltest.NewHash(), Index: 0, BlockNumber: 1500}
+	sameBlock := types.Log{TxHash: handled.TxHash, Index: 1, BlockNumber: 1500}
+	checkpoint := models.NewLogCheckpoint(services.InitiatorCheckpointID(initr))
+	checkpoint.BlockNumber = 1500
+	checkpoint.MarkHandled(handled)
+	assert.NoError(t, store.Save(checkpoint))
+
+	eth.Register("eth_blockNumber", utils.Uint64ToHex(2600))
+	eth.Register("eth_getLogs", []types.Log{handled, sameBlock},
+		func(_ interface{}, data ...interface{}) error {
+			filter := data[0].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, "0x5dc", filter["fromBlock"])
+			assert.Equal(t, "0x9c3", filter["toBlock"])
+			return nil
+		})
+	eth.Register("eth_getLogs", []types.Log{},
+		func(_ interface{}, data ...interface{}) error {
+			filter := data[0].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, "0x9c4", filter["fromBlock"])
+			assert.Equal(t, "0xa28", filter["toBlock"])
+			return nil
+		})
+	eth.RegisterSubscription("logs")
+
+	var count int32
+	callback := func(services.InitiatorSubscriptionLogEvent) { atomic.AddInt32(&count, 1) }
+	filter := services.NewInitiatorFilterQuery(initr, cltest.IndexableBlockNumber(2000), nil)
+	sub, err := services.NewInitiatorSubscription(initr, job, store, filter, callback)
+	assert.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	eth.EventuallyAllCalled(t)
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() uint64 {
+		saved, err := store.FindLogCheckpoint(checkpoint.ID)
+		assert.NoError(t, err)
+		return saved.BlockNumber
+	}).Should(gomega.Equal(uint64(2601)))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
 func TestTopicFiltersForRunLog(t *testing.T) {
 	t.Parallel()
 
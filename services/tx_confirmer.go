@@ -0,0 +1,70 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// TxConfirmer follows the node's transactions independently of the job runs
+// which sent them. On every new head it bumps the gas of transactions pending
+// for too long and confirms those mined deep enough, then resumes the runs
+// waiting on transactions that were confirmed or have failed.
+type TxConfirmer struct {
+	Store *store.Store
+}
+
+// Connect satisfies the HeadTrackable interface.
+func (tc *TxConfirmer) Connect(*models.IndexableBlockNumber) error {
+	return nil
+}
+
+// Disconnect satisfies the HeadTrackable interface.
+func (tc *TxConfirmer) Disconnect() {}
+
+// OnNewHead checks the unconfirmed transactions at the new head and resumes
+// the runs whose transaction is no longer pending.
+func (tc *TxConfirmer) OnNewHead(head *models.BlockHeader) {
+	bn := head.ToIndexableBlockNumber()
+	if err := tc.Store.TxManager.CheckUnconfirmed(bn.ToInt().Uint64()); err != nil {
+		logger.Error(err.Error())
+	}
+
+	pendingRuns, err := tc.Store.JobRunsWithStatus(models.RunStatusPendingConfirmations)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	for _, jr := range pendingRuns {
+		tx, ok := awaitedTx(jr, tc.Store)
+		if !ok || !(tx.Confirmed || tx.Failed) {
+			continue
+		}
+		if _, err := ExecuteRunAtBlock(jr, tc.Store, models.RunResult{}, bn); err != nil {
+			logger.Error(err.Error())
+		}
+	}
+}
+
+// OnReorg satisfies the HeadTrackable interface.
+func (tc *TxConfirmer) OnReorg(*models.IndexableBlockNumber) {}
+
+// awaitedTx returns the transaction sent by an EthTx task of the run that is
+// waiting for it to be confirmed.
+func awaitedTx(jr models.JobRun, store *store.Store) (*models.Tx, bool) {
+	for _, tr := range jr.TaskRuns {
+		if !tr.Status.PendingConfirmations() || strings.ToLower(tr.Task.Type) != "ethtx" {
+			continue
+		}
+		hash := tr.Result.Get("value").String()
+		if hash == "" {
+			continue
+		}
+		if tx, err := store.FindTxByAttempt(common.HexToHash(hash)); err == nil {
+			return tx, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,57 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxConfirmer_OnNewHead(t *testing.T) {
+	t.Parallel()
+
+	sentAt := uint64(1)
+	tests := []struct {
+		name          string
+		receipt       strpkg.TxReceipt
+		head          int
+		wantConfirmed bool
+		wantStatus    models.RunStatus
+	}{
+		{"not mined", strpkg.TxReceipt{}, 2, false, models.RunStatusPendingConfirmations},
+		{"not deep enough", strpkg.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.BigHexInt(2)}, 3, false, models.RunStatusPendingConfirmations},
+		{"confirmed", strpkg.TxReceipt{Hash: cltest.NewHash(), BlockNumber: cltest.BigHexInt(2)}, 10, true, models.RunStatusCompleted},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore()
+			defer cleanup()
+			ethMock := cltest.MockEthOnStore(store)
+			tc := &services.TxConfirmer{Store: store}
+
+			tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), sentAt)
+			job, initr := cltest.NewJobWithWebInitiator()
+			job.Tasks = []models.TaskSpec{cltest.NewTask("ethtx", `{"address":"0x0000000000000000000000000000000000000abc"}`)}
+			assert.NoError(t, store.SaveJob(&job))
+			run := job.NewRun(initr)
+			result := cltest.RunResultWithValue(tx.Hash.String()).MarkPendingConfirmations()
+			run.TaskRuns[0] = run.TaskRuns[0].ApplyResult(result)
+			run = run.ApplyResult(result)
+			assert.NoError(t, store.Save(&run))
+
+			ethMock.Register("eth_getTransactionReceipt", test.receipt)
+			tc.OnNewHead(cltest.NewBlockHeader(test.head))
+			ethMock.EventuallyAllCalled(t)
+
+			assert.NoError(t, store.One("ID", tx.ID, tx))
+			assert.Equal(t, test.wantConfirmed, tx.Confirmed)
+			refreshed, err := store.FindJobRun(run.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantStatus, refreshed.Status)
+		})
+	}
+}
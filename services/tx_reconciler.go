@@ -0,0 +1,63 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store"
+)
+
+// txReconcilerInterval is how often the TxReconciler compares the node's
+// transactions with the chain.
+const txReconcilerInterval = time.Minute
+
+// TxReconciler periodically repairs the transactions of the node's accounts:
+// dropped transactions are resent, nonce gaps are filled and transactions
+// replaced by another wallet are marked as failed.
+type TxReconciler struct {
+	store *store.Store
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// NewTxReconciler returns a TxReconciler for the accounts of the given
+// store's TxManager.
+func NewTxReconciler(store *store.Store) *TxReconciler {
+	return &TxReconciler{store: store}
+}
+
+// Start begins periodically reconciling the accounts' transactions.
+func (r *TxReconciler) Start() error {
+	if r.done != nil {
+		return errors.New("TxReconciler already started")
+	}
+	r.done = make(chan struct{})
+	r.wg.Add(1)
+	go r.listen()
+	return nil
+}
+
+// Stop stops the TxReconciler and waits for a reconciliation in progress.
+func (r *TxReconciler) Stop() {
+	if r.done != nil {
+		close(r.done)
+		r.wg.Wait()
+		r.done = nil
+	}
+}
+
+func (r *TxReconciler) listen() {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-r.store.Clock.After(txReconcilerInterval):
+			if err := r.store.TxManager.ReconcileNonces(); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+	}
+}
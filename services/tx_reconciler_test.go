@@ -0,0 +1,23 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxReconciler_StartStop(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	reconciler := services.NewTxReconciler(store)
+	assert.NoError(t, reconciler.Start())
+	assert.Error(t, reconciler.Start())
+	reconciler.Stop()
+	assert.NoError(t, reconciler.Start())
+	reconciler.Stop()
+}
@@ -1,11 +1,13 @@
 package services
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/store"
 	"github.com/smartcontractkit/chainlink/store/models"
@@ -19,6 +21,9 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 	if j.StartAt.Valid && j.EndAt.Valid && j.StartAt.Time.After(j.EndAt.Time) {
 		merr = multierr.Append(merr, fmtJobError(errors.New("startat cannot be before endat")))
 	}
+	if j.Archived() {
+		merr = multierr.Append(merr, fmtJobError(errors.New("archivedat cannot be set")))
+	}
 	if len(j.Initiators) < 1 || len(j.Tasks) < 1 {
 		merr = multierr.Append(merr, fmtJobError(errors.New("Must have at least one Initiator and one Task")))
 	}
@@ -32,6 +37,9 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 			merr = multierr.Append(merr, fmtJobError(err))
 		}
 	}
+	if err := validateTaskGraph(j.Tasks); err != nil {
+		merr = multierr.Append(merr, fmtJobError(err))
+	}
 	return merr
 }
 
@@ -102,6 +110,81 @@ func validateTask(task models.TaskSpec, store *store.Store) error {
 	if _, err := adapters.For(task, store); err != nil {
 		return fmt.Errorf("task validation: %v", err)
 	}
+	if strings.ToLower(task.Type) == "ethtx" {
+		if err := validateGasPrice(task); err != nil {
+			return err
+		}
+		return validateSendingAddress(task, store)
+	}
+	return nil
+}
+
+func validateGasPrice(task models.TaskSpec) error {
+	param := task.Params.Get("gasPrice")
+	if !param.Exists() {
+		return nil
+	}
+	var gps store.GasPriceStrategy
+	if err := json.Unmarshal([]byte(param.Raw), &gps); err != nil {
+		return fmt.Errorf("task validation: gasPrice %v", err)
+	}
+	if err := gps.Validate(); err != nil {
+		return fmt.Errorf("task validation: %v", err)
+	}
+	return nil
+}
+
+func validateSendingAddress(task models.TaskSpec, store *store.Store) error {
+	from := task.Params.Get("from")
+	if !from.Exists() {
+		return nil
+	}
+	if !common.IsHexAddress(from.String()) {
+		return fmt.Errorf("task validation: from %v is not an address", from.String())
+	}
+	if !store.KeyStore.HasAddress(common.HexToAddress(from.String())) {
+		return fmt.Errorf("task validation: from %v is not an account of this node", from.String())
+	}
+	return nil
+}
+
+func validateTaskGraph(tasks []models.TaskSpec) error {
+	declared := map[string]bool{}
+	for _, task := range tasks {
+		for _, parent := range task.Parents {
+			if !declared[parent] {
+				return fmt.Errorf("task validation: parent %v must be the id of a task listed before it", parent)
+			}
+		}
+		if task.ID == "" {
+			continue
+		}
+		if declared[task.ID] {
+			return fmt.Errorf("task validation: duplicate task id %v", task.ID)
+		}
+		declared[task.ID] = true
+	}
+	return validateTerminalTask(tasks)
+}
+
+// validateTerminalTask ensures that the last task is the only one that no
+// other task follows, so that its result is the result of the run.
+func validateTerminalTask(tasks []models.TaskSpec) error {
+	followed := map[string]bool{}
+	for _, task := range tasks {
+		for _, parent := range task.Parents {
+			followed[parent] = true
+		}
+	}
+	if len(followed) == 0 {
+		return nil
+	}
+
+	for i, task := range tasks[:len(tasks)-1] {
+		if !followed[task.ID] {
+			return fmt.Errorf("task validation: task %v must be the parent of another task, only the last task can end the graph", i)
+		}
+	}
 	return nil
 }
 
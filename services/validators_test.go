@@ -26,10 +26,21 @@ func TestValidateJob(t *testing.T) {
 		{"base case", cltest.LoadJSON("../internal/fixtures/web/hello_world_job.json"), nil},
 		{"error in job", cltest.LoadJSON("../internal/fixtures/web/invalid_endat_job.json"),
 			errors.New(`job validation: startat cannot be before endat`)},
+		{"archived job", cltest.LoadJSON("../internal/fixtures/web/archived_job.json"),
+			errors.New(`job validation: archivedat cannot be set`)},
 		{"error in runat initr", cltest.LoadJSON("../internal/fixtures/web/run_at_wo_time_job.json"),
 			errors.New(`job validation: initiator validation: runat must have a time`)},
 		{"error in task", cltest.LoadJSON("../internal/fixtures/web/nonexistent_task_job.json"),
 			errors.New(`job validation: task validation: idonotexist is not a supported adapter type`)},
+		{"task graph", cltest.LoadJSON("../internal/fixtures/web/fan_out_job.json"), nil},
+		{"error in task parents", cltest.LoadJSON("../internal/fixtures/web/unknown_parent_job.json"),
+			errors.New(`job validation: task validation: parent second must be the id of a task listed before it`)},
+		{"error in task graph end", cltest.LoadJSON("../internal/fixtures/web/two_terminal_tasks_job.json"),
+			errors.New(`job validation: task validation: task 1 must be the parent of another task, only the last task can end the graph`)},
+		{"error in task sending address", cltest.LoadJSON("../internal/fixtures/web/unknown_from_job.json"),
+			errors.New(`job validation: task validation: from 0x0000000000000000000000000000000000000abc is not an account of this node`)},
+		{"error in task gas price", cltest.LoadJSON("../internal/fixtures/web/too_many_gas_price_blocks_job.json"),
+			errors.New(`job validation: task validation: gas price blocks must be at most 100, got 1000`)},
 	}
 
 	store, cleanup := cltest.NewStore()
@@ -7,6 +7,8 @@ import (
 	"os"
 	"path"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env"
@@ -14,6 +16,7 @@ import (
 	"github.com/gin-gonic/gin"
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -28,6 +31,8 @@ type Config struct {
 	BasicAuthUsername      string          `env:"USERNAME" envDefault:"chainlink"`
 	BasicAuthPassword      string          `env:"PASSWORD" envDefault:"twochains"`
 	EthereumURL            string          `env:"ETH_URL" envDefault:"ws://localhost:8546"`
+	EthereumBackupURLs     []string        `env:"ETH_BACKUP_URLS" envSeparator:","`
+	EthPollInterval        Duration        `env:"ETH_POLL_INTERVAL" envDefault:"15s"`
 	ChainID                uint64          `env:"ETH_CHAIN_ID" envDefault:"0"`
 	ClientNodeURL          string          `env:"CLIENT_NODE_URL" envDefault:"http://localhost:6688"`
 	TxMinConfirmations     uint64          `env:"TX_MIN_CONFIRMATIONS" envDefault:"12"`
@@ -35,8 +40,10 @@ type Config struct {
 	EthGasBumpThreshold    uint64          `env:"ETH_GAS_BUMP_THRESHOLD" envDefault:"12"`
 	EthGasBumpWei          big.Int         `env:"ETH_GAS_BUMP_WEI" envDefault:"5000000000"`
 	EthGasPriceDefault     big.Int         `env:"ETH_GAS_PRICE_DEFAULT" envDefault:"20000000000"`
+	EthGasPriceMax         big.Int         `env:"ETH_GAS_PRICE_MAX" envDefault:"500000000000"`
 	LinkContractAddress    string          `env:"LINK_CONTRACT_ADDRESS" envDefault:"0x514910771AF9Ca656af840dff83E8264EcF986CA"`
 	MinimumContractPayment big.Int         `env:"MINIMUM_CONTRACT_PAYMENT" envDefault:"1000000000000000000"`
+	AdapterPayments        AdapterPayments `env:"ADAPTER_PAYMENTS"`
 	OracleContractAddress  *common.Address `env:"ORACLE_CONTRACT_ADDRESS"`
 	DatabasePollInterval   Duration        `env:"DATABASE_POLL_INTERVAL" envDefault:"500ms"`
 }
@@ -64,6 +71,18 @@ func (c Config) KeysDir() string {
 	return path.Join(c.RootDir, "keys")
 }
 
+// EthereumURLs returns the URL of the primary Ethereum node followed by those
+// of its backups, in the order they are failed over to.
+func (c Config) EthereumURLs() []string {
+	urls := []string{c.EthereumURL}
+	for _, url := range c.EthereumBackupURLs {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
 // CreateProductionLogger returns a custom logger for the config's root directory
 // and LogLevel, with pretty printing for stdout.
 func (c Config) CreateProductionLogger() *zap.Logger {
@@ -77,6 +96,8 @@ func (c Config) String() string {
 		"GUI_PORT: %s\n" +
 		"USERNAME: %s\n" +
 		"ETH_URL: %s\n" +
+		"ETH_BACKUP_URLS: %s\n" +
+		"ETH_POLL_INTERVAL: %s\n" +
 		"ETH_CHAIN_ID: %d\n" +
 		"CLIENT_NODE_URL: %s\n" +
 		"TX_MIN_CONFIRMATIONS: %d\n" +
@@ -84,8 +105,10 @@ func (c Config) String() string {
 		"ETH_GAS_BUMP_THRESHOLD: %d\n" +
 		"ETH_GAS_BUMP_WEI: %s\n" +
 		"ETH_GAS_PRICE_DEFAULT: %s\n" +
+		"ETH_GAS_PRICE_MAX: %s\n" +
 		"LINK_CONTRACT_ADDRESS: %s\n" +
 		"MINIMUM_CONTRACT_PAYMENT: %s\n" +
+		"ADAPTER_PAYMENTS: %s\n" +
 		"ORACLE_CONTRACT_ADDRESS: %s\n" +
 		"DATABASE_POLL_INTERVAL: %s\n"
 
@@ -102,6 +125,8 @@ func (c Config) String() string {
 		c.GuiPort,
 		c.BasicAuthUsername,
 		c.EthereumURL,
+		strings.Join(c.EthereumBackupURLs, ","),
+		c.EthPollInterval,
 		c.ChainID,
 		c.ClientNodeURL,
 		c.TxMinConfirmations,
@@ -109,8 +134,10 @@ func (c Config) String() string {
 		c.EthGasBumpThreshold,
 		c.EthGasBumpWei.String(),
 		c.EthGasPriceDefault.String(),
+		c.EthGasPriceMax.String(),
 		c.LinkContractAddress,
 		c.MinimumContractPayment.String(),
+		c.AdapterPayments,
 		oracleContractAddress,
 		c.DatabasePollInterval,
 	)
@@ -122,6 +149,7 @@ func parseEnv(cfg interface{}) error {
 		reflect.TypeOf(big.Int{}):         bigIntParser,
 		reflect.TypeOf(LogLevel{}):        levelParser,
 		reflect.TypeOf(Duration{}):        durationParser,
+		reflect.TypeOf(AdapterPayments{}): adapterPaymentsParser,
 	})
 }
 
@@ -157,6 +185,47 @@ func durationParser(str string) (interface{}, error) {
 	return Duration{Duration: d}, err
 }
 
+// adapterPaymentsParser parses a comma separated list of adapter types and
+// the payment they require in the smallest unit of LINK, such as
+// "httpGet:1000,ethTx:2000".
+func adapterPaymentsParser(str string) (interface{}, error) {
+	payments := AdapterPayments{}
+	for _, entry := range strings.Split(str, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Unable to parse '%s' into adapter and payment", entry)
+		}
+		payment, ok := new(assets.Link).SetString(strings.TrimSpace(parts[1]), 10)
+		if !ok {
+			return nil, fmt.Errorf("Unable to parse payment '%s' for adapter %s", parts[1], parts[0])
+		}
+		payments[strings.ToLower(strings.TrimSpace(parts[0]))] = payment
+	}
+	return payments, nil
+}
+
+// AdapterPayments holds the payment, in the smallest unit of LINK, that the
+// node requires for each task using an adapter, keyed by the adapter's type.
+type AdapterPayments map[string]*assets.Link
+
+// For returns the payment required for a task of the given type, or nil if
+// there is none.
+func (ap AdapterPayments) For(taskType string) *assets.Link {
+	return ap[strings.ToLower(taskType)]
+}
+
+func (ap AdapterPayments) String() string {
+	entries := []string{}
+	for taskType, payment := range ap {
+		entries = append(entries, fmt.Sprintf("%s:%s", taskType, (*big.Int)(payment).Text(10)))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
 // LogLevel determines the verbosity of the events to be logged.
 type LogLevel struct {
 	zapcore.Level
@@ -3,8 +3,10 @@ package store
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zapcore"
 )
@@ -14,8 +16,10 @@ func TestStore_ConfigDefaults(t *testing.T) {
 	config := NewConfig()
 	assert.Equal(t, uint64(0), config.ChainID)
 	assert.Equal(t, *big.NewInt(20000000000), config.EthGasPriceDefault)
+	assert.Equal(t, *big.NewInt(500000000000), config.EthGasPriceMax)
 	assert.Equal(t, "0x514910771AF9Ca656af840dff83E8264EcF986CA", common.HexToAddress(config.LinkContractAddress).String())
 	assert.Equal(t, *big.NewInt(1000000000000000000), config.MinimumContractPayment)
+	assert.Equal(t, 15*time.Second, config.EthPollInterval.Duration)
 }
 
 func TestStore_addressParser(t *testing.T) {
@@ -77,3 +81,32 @@ func TestStore_levelParser(t *testing.T) {
 	val, err = levelParser("primus sucks")
 	assert.Error(t, err)
 }
+
+func TestConfig_EthereumURLs(t *testing.T) {
+	t.Parallel()
+	config := Config{
+		EthereumURL:        "ws://primary",
+		EthereumBackupURLs: []string{"ws://backup", " ", " wss://infura "},
+	}
+	assert.Equal(t, []string{"ws://primary", "ws://backup", "wss://infura"}, config.EthereumURLs())
+}
+
+func TestStore_adapterPaymentsParser(t *testing.T) {
+	val, err := adapterPaymentsParser("")
+	assert.NoError(t, err)
+	assert.Equal(t, AdapterPayments{}, val)
+
+	val, err = adapterPaymentsParser("httpGet:100, ethTx : 2000")
+	assert.NoError(t, err)
+	payments := val.(AdapterPayments)
+	assert.Equal(t, assets.NewLink(100), payments.For("httpget"))
+	assert.Equal(t, assets.NewLink(2000), payments.For("ethTx"))
+	assert.Nil(t, payments.For("noop"))
+	assert.Equal(t, "ethtx:2000,httpget:100", payments.String())
+
+	_, err = adapterPaymentsParser("httpGet")
+	assert.Error(t, err)
+
+	_, err = adapterPaymentsParser("httpGet:x")
+	assert.Error(t, err)
+}
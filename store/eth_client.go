@@ -22,10 +22,16 @@ type EthClient struct {
 // CallerSubscriber implements the Call and EthSubscribe functions. Call performs
 // a JSON-RPC call with the given arguments and EthSubscribe registers a subscription.
 type CallerSubscriber interface {
-	Call(result interface{}, method string, args ...interface{}) error
+	Caller
 	EthSubscribe(context.Context, interface{}, ...interface{}) (models.EthSubscription, error)
 }
 
+// Caller implements the Call function, performing a JSON-RPC call with the
+// given arguments.
+type Caller interface {
+	Call(result interface{}, method string, args ...interface{}) error
+}
+
 // GetNonce returns the nonce (transaction count) for a given address.
 func (eth *EthClient) GetNonce(address common.Address) (uint64, error) {
 	result := ""
@@ -36,6 +42,17 @@ func (eth *EthClient) GetNonce(address common.Address) (uint64, error) {
 	return utils.HexToUint64(result)
 }
 
+// GetNonceAt returns the nonce (transaction count) of the address as of the
+// given block number.
+func (eth *EthClient) GetNonceAt(address common.Address, number uint64) (uint64, error) {
+	result := ""
+	err := eth.Call(&result, "eth_getTransactionCount", address.Hex(), hexutil.EncodeUint64(number))
+	if err != nil {
+		return 0, err
+	}
+	return utils.HexToUint64(result)
+}
+
 // GetWeiBalance returns the balance of the given address in Wei.
 func (eth *EthClient) GetWeiBalance(address common.Address) (*big.Int, error) {
 	result := ""
@@ -105,6 +122,14 @@ func (eth *EthClient) GetTxReceipt(hash common.Hash) (*TxReceipt, error) {
 	return &receipt, err
 }
 
+// GetTxByHash returns the transaction with the given hash, or nil when the
+// node does not know of it.
+func (eth *EthClient) GetTxByHash(hash common.Hash) (*models.BlockTransaction, error) {
+	var tx *models.BlockTransaction
+	err := eth.Call(&tx, "eth_getTransactionByHash", hash.String())
+	return tx, err
+}
+
 // GetBlockNumber returns the block number of the chain head.
 func (eth *EthClient) GetBlockNumber() (uint64, error) {
 	result := ""
@@ -121,6 +146,23 @@ func (eth *EthClient) GetBlockByNumber(hex string) (models.BlockHeader, error) {
 	return header, err
 }
 
+// GetBlockWithTransactions returns the block at the given number along with
+// its transactions.
+func (eth *EthClient) GetBlockWithTransactions(number uint64) (models.BlockWithTransactions, error) {
+	var block models.BlockWithTransactions
+	err := eth.Call(&block, "eth_getBlockByNumber", hexutil.EncodeUint64(number), true)
+	return block, err
+}
+
+// GetGasPrice returns the gas price in Wei suggested by the node.
+func (eth *EthClient) GetGasPrice() (*big.Int, error) {
+	result := ""
+	if err := eth.Call(&result, "eth_gasPrice"); err != nil {
+		return nil, err
+	}
+	return hexutil.DecodeBig(result)
+}
+
 // GetLogs returns all logs that respect the passed filter query.
 func (eth *EthClient) GetLogs(q ethereum.FilterQuery) ([]types.Log, error) {
 	var results []types.Log
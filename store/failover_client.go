@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// FailoverClient is a CallerSubscriber spread over a primary Ethereum node
+// and its backups. Requests go to the current node until it can no longer be
+// reached, at which point the client fails over to the next node that is
+// healthy, in the order the URLs were given.
+//
+// Failing over closes the connection to the unreachable node, which ends its
+// subscriptions so that their owners subscribe again on the new node.
+type FailoverClient struct {
+	dialer Dialer
+	urls   []string
+	index  int
+	client CallerSubscriber
+	mutex  sync.Mutex
+}
+
+// NewFailoverClient dials the first of the given URLs that can be reached.
+func NewFailoverClient(dialer Dialer, urls []string) (*FailoverClient, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("no Ethereum node URL given")
+	}
+
+	fc := &FailoverClient{dialer: dialer, urls: urls}
+	failures := []string{}
+	for i, url := range urls {
+		client, err := dialer.Dial(url)
+		if err == nil {
+			fc.index = i
+			fc.client = client
+			return fc, nil
+		}
+		logger.Warnw(fmt.Sprintf("Unable to dial Ethereum node %v", url), "err", err)
+		failures = append(failures, fmt.Sprintf("%v: %v", url, err))
+	}
+	return nil, fmt.Errorf("unable to dial any Ethereum node: %v", strings.Join(failures, "; "))
+}
+
+// sendMethods are not called again after failing over, as the unreachable
+// node may already have broadcast the transaction before the connection
+// dropped.
+var sendMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+// Call performs the JSON-RPC call on the current node, failing over and
+// calling again if the node cannot be reached. Transactions are not sent
+// again; the original error is returned once the client has failed over.
+func (fc *FailoverClient) Call(result interface{}, method string, args ...interface{}) error {
+	client := fc.current()
+	err := client.Call(result, method, args...)
+	if !unreachable(err) {
+		return err
+	}
+
+	client, ferr := fc.failover(client, err)
+	if ferr != nil {
+		return ferr
+	}
+	if sendMethods[method] {
+		return err
+	}
+	return client.Call(result, method, args...)
+}
+
+// EthSubscribe registers the subscription on the current node, failing over
+// and subscribing again if the node cannot be reached.
+func (fc *FailoverClient) EthSubscribe(
+	ctx context.Context,
+	channel interface{},
+	args ...interface{},
+) (models.EthSubscription, error) {
+	client := fc.current()
+	sub, err := client.EthSubscribe(ctx, channel, args...)
+	if !unreachable(err) {
+		return sub, err
+	}
+
+	client, ferr := fc.failover(client, err)
+	if ferr != nil {
+		return nil, ferr
+	}
+	return client.EthSubscribe(ctx, channel, args...)
+}
+
+func (fc *FailoverClient) current() CallerSubscriber {
+	fc.mutex.Lock()
+	defer fc.mutex.Unlock()
+	return fc.client
+}
+
+// failover replaces the failed client with one for the next healthy node,
+// trying the failed node's URL again last. If another request has already
+// failed over, its client is used instead.
+func (fc *FailoverClient) failover(failed CallerSubscriber, cause error) (CallerSubscriber, error) {
+	fc.mutex.Lock()
+	defer fc.mutex.Unlock()
+	if fc.client != failed {
+		return fc.client, nil
+	}
+
+	logger.Warnw(fmt.Sprintf("Ethereum node %v unreachable, failing over", fc.urls[fc.index]), "err", cause)
+	closeClient(failed)
+	for i := 1; i <= len(fc.urls); i++ {
+		index := (fc.index + i) % len(fc.urls)
+		url := fc.urls[index]
+		client, err := fc.dialer.Dial(url)
+		if err == nil {
+			err = checkHealth(client)
+		}
+		if err != nil {
+			logger.Warnw(fmt.Sprintf("Ethereum node %v is not healthy", url), "err", err)
+			closeClient(client)
+			continue
+		}
+
+		logger.Info("Failed over to Ethereum node ", url)
+		fc.index = index
+		fc.client = client
+		return client, nil
+	}
+	return nil, fmt.Errorf("no Ethereum node reachable, last error: %v", cause)
+}
+
+// checkHealth returns an error unless the node answers with its latest
+// block number.
+func checkHealth(client CallerSubscriber) error {
+	result := ""
+	return client.Call(&result, "eth_blockNumber")
+}
+
+func closeClient(client CallerSubscriber) {
+	if closer, ok := client.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+// unreachable returns true if the error came from the transport rather than
+// from the node answering the request, such as a dropped connection or a
+// timeout. Error responses and results that fail to decode do not count.
+func unreachable(err error) bool {
+	switch err {
+	case nil:
+		return false
+	case io.EOF, io.ErrUnexpectedEOF, rpc.ErrClientQuit, context.DeadlineExceeded:
+		return true
+	}
+	_, transport := err.(net.Error)
+	return transport
+}
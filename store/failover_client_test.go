@@ -0,0 +1,135 @@
+package store_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type failoverDialer map[string]*cltest.EthMock
+
+func (d failoverDialer) Dial(url string) (strpkg.CallerSubscriber, error) {
+	if mock, ok := d[url]; ok {
+		return mock, nil
+	}
+	return nil, errors.New("connection refused")
+}
+
+func TestNewFailoverClient(t *testing.T) {
+	t.Parallel()
+
+	backup := &cltest.EthMock{}
+	dialer := failoverDialer{"ws://backup": backup}
+
+	fc, err := strpkg.NewFailoverClient(dialer, []string{"ws://primary", "ws://backup"})
+	assert.NoError(t, err)
+
+	backup.Register("eth_blockNumber", utils.Uint64ToHex(7))
+	result := ""
+	assert.NoError(t, fc.Call(&result, "eth_blockNumber"))
+	assert.Equal(t, utils.Uint64ToHex(7), result)
+
+	_, err = strpkg.NewFailoverClient(dialer, []string{"ws://primary"})
+	assert.Error(t, err)
+	_, err = strpkg.NewFailoverClient(dialer, []string{})
+	assert.Error(t, err)
+}
+
+func TestFailoverClient_Call(t *testing.T) {
+	t.Parallel()
+
+	primary := &cltest.EthMock{}
+	unhealthy := &cltest.EthMock{}
+	backup := &cltest.EthMock{}
+	dialer := failoverDialer{
+		"ws://primary":   primary,
+		"ws://unhealthy": unhealthy,
+		"ws://backup":    backup,
+	}
+	fc, err := strpkg.NewFailoverClient(dialer, []string{"ws://primary", "ws://unhealthy", "ws://backup"})
+	assert.NoError(t, err)
+
+	primary.RegisterConnectionError("eth_getTransactionCount")
+	unhealthy.RegisterError("eth_blockNumber", "syncing")
+	backup.Register("eth_blockNumber", utils.Uint64ToHex(7))
+	backup.Register("eth_getTransactionCount", utils.Uint64ToHex(3))
+	backup.Register("eth_getTransactionCount", utils.Uint64ToHex(4))
+
+	eth := &strpkg.EthClient{CallerSubscriber: fc}
+	nonce, err := eth.GetNonce(cltest.NewAddress())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), nonce)
+
+	nonce, err = eth.GetNonce(cltest.NewAddress())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), nonce)
+	assert.True(t, primary.AllCalled())
+	assert.True(t, unhealthy.AllCalled())
+	assert.True(t, backup.AllCalled())
+}
+
+func TestFailoverClient_Call_NoneReachable(t *testing.T) {
+	t.Parallel()
+
+	primary := &cltest.EthMock{}
+	fc, err := strpkg.NewFailoverClient(failoverDialer{"ws://primary": primary}, []string{"ws://primary", "ws://backup"})
+	assert.NoError(t, err)
+
+	primary.RegisterConnectionError("eth_getTransactionCount")
+	primary.RegisterError("eth_blockNumber", "connection refused")
+
+	eth := &strpkg.EthClient{CallerSubscriber: fc}
+	_, err = eth.GetNonce(cltest.NewAddress())
+	assert.Error(t, err)
+	assert.True(t, primary.AllCalled())
+}
+
+func TestFailoverClient_Call_AnsweredErrorsStayOnNode(t *testing.T) {
+	t.Parallel()
+
+	primary := &cltest.EthMock{}
+	backup := &cltest.EthMock{}
+	dialer := failoverDialer{"ws://primary": primary, "ws://backup": backup}
+	fc, err := strpkg.NewFailoverClient(dialer, []string{"ws://primary", "ws://backup"})
+	assert.NoError(t, err)
+
+	primary.RegisterError("eth_getTransactionCount", "invalid character 'x' looking for beginning of value")
+	primary.Register("eth_getTransactionCount", utils.Uint64ToHex(3))
+
+	eth := &strpkg.EthClient{CallerSubscriber: fc}
+	_, err = eth.GetNonce(cltest.NewAddress())
+	assert.Error(t, err)
+
+	nonce, err := eth.GetNonce(cltest.NewAddress())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), nonce)
+	assert.True(t, primary.AllCalled())
+}
+
+func TestFailoverClient_Call_DoesNotResendTransactions(t *testing.T) {
+	t.Parallel()
+
+	primary := &cltest.EthMock{}
+	backup := &cltest.EthMock{}
+	dialer := failoverDialer{"ws://primary": primary, "ws://backup": backup}
+	fc, err := strpkg.NewFailoverClient(dialer, []string{"ws://primary", "ws://backup"})
+	assert.NoError(t, err)
+
+	primary.RegisterConnectionError("eth_sendRawTransaction")
+	backup.Register("eth_blockNumber", utils.Uint64ToHex(7))
+	backup.Register("eth_getTransactionCount", utils.Uint64ToHex(4))
+
+	eth := &strpkg.EthClient{CallerSubscriber: fc}
+	_, err = eth.SendRawTx("0x0")
+	assert.Error(t, err)
+
+	nonce, err := eth.GetNonce(cltest.NewAddress())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), nonce)
+	assert.True(t, primary.AllCalled())
+	assert.True(t, backup.AllCalled())
+}
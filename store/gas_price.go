@@ -0,0 +1,174 @@
+package store
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/store/assets"
+)
+
+// The gas price strategies a transaction can be created with.
+const (
+	// GasPriceFixed uses the given Price, or the configured default.
+	GasPriceFixed = "fixed"
+	// GasPriceNetwork uses the price suggested by the node via eth_gasPrice.
+	GasPriceNetwork = "network"
+	// GasPricePercentile uses the Percentile of the gas prices paid in the
+	// most recent Blocks.
+	GasPricePercentile = "percentile"
+)
+
+// defaultGasPriceBlocks is the number of blocks sampled by the percentile
+// strategy when none is given.
+const defaultGasPriceBlocks uint64 = 20
+
+// MaxGasPriceBlocks is the most blocks the percentile strategy may sample.
+const MaxGasPriceBlocks uint64 = 100
+
+// GasPriceStrategy determines the gas price of a new transaction. Whichever
+// strategy is used, the price never exceeds the configured EthGasPriceMax.
+type GasPriceStrategy struct {
+	Strategy   string      `json:"strategy"`
+	Price      *assets.Eth `json:"price"`
+	Percentile float64     `json:"percentile"`
+	Blocks     uint64      `json:"blocks"`
+}
+
+// Validate returns an error if the strategy is unknown or its settings are
+// out of range.
+func (gps GasPriceStrategy) Validate() error {
+	switch gps.Strategy {
+	case "", GasPriceFixed, GasPriceNetwork:
+		return nil
+	case GasPricePercentile:
+		if gps.Percentile <= 0 || gps.Percentile > 100 {
+			return fmt.Errorf("gas price percentile must be above 0 and at most 100, got %v", gps.Percentile)
+		}
+		if gps.Blocks > MaxGasPriceBlocks {
+			return fmt.Errorf("gas price blocks must be at most %v, got %v", MaxGasPriceBlocks, gps.Blocks)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown gas price strategy %v", gps.Strategy)
+	}
+}
+
+// GasPrice returns the gas price in Wei for the given strategy, capped at
+// the configured maximum.
+func (txm *TxManager) GasPrice(gps GasPriceStrategy) (*big.Int, error) {
+	if err := gps.Validate(); err != nil {
+		return nil, err
+	}
+
+	var price *big.Int
+	var err error
+	switch gps.Strategy {
+	case "", GasPriceFixed:
+		price = new(big.Int).Set(&txm.config.EthGasPriceDefault)
+		if gps.Price != nil {
+			price = new(big.Int).Set((*big.Int)(gps.Price))
+		}
+	case GasPriceNetwork:
+		price, err = txm.GetGasPrice()
+	case GasPricePercentile:
+		price, err = txm.percentileGasPrice(gps)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return txm.capGasPrice(price), nil
+}
+
+func (txm *TxManager) capGasPrice(price *big.Int) *big.Int {
+	if price.Cmp(&txm.config.EthGasPriceMax) > 0 {
+		return new(big.Int).Set(&txm.config.EthGasPriceMax)
+	}
+	return price
+}
+
+// percentileGasPrice returns the percentile of the gas prices of the
+// transactions in the recent blocks, or the configured default when those
+// blocks are empty.
+func (txm *TxManager) percentileGasPrice(gps GasPriceStrategy) (*big.Int, error) {
+	blocks := gps.Blocks
+	if blocks == 0 {
+		blocks = defaultGasPriceBlocks
+	}
+
+	latest, err := txm.GetBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	prices := []*big.Int{}
+	for i := uint64(0); i < blocks && i <= latest; i++ {
+		blockPrices, err := txm.blockGasPrices(latest-i, latest)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, blockPrices...)
+	}
+	if len(prices) == 0 {
+		return new(big.Int).Set(&txm.config.EthGasPriceDefault), nil
+	}
+
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) < 0 })
+	index := int(math.Ceil(gps.Percentile/100*float64(len(prices)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return new(big.Int).Set(prices[index]), nil
+}
+
+// blockGasPrices returns the gas prices paid in the block with the given
+// number, fetching the block only if it is not cached yet.
+func (txm *TxManager) blockGasPrices(number uint64, latest uint64) ([]*big.Int, error) {
+	if prices, ok := txm.gasPrices.get(number); ok {
+		return prices, nil
+	}
+
+	block, err := txm.GetBlockWithTransactions(number)
+	if err != nil {
+		return nil, err
+	}
+	prices := make([]*big.Int, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		prices[i] = tx.GasPrice.ToInt()
+	}
+	txm.gasPrices.add(number, prices, latest)
+	return prices, nil
+}
+
+// gasPriceCache holds the gas prices paid in the most recent blocks, so
+// that each block is only fetched once however many transactions are
+// priced from it. Blocks replaced by a chain reorganization keep their
+// cached prices, which remain a fair sample of the recent prices.
+type gasPriceCache struct {
+	blocks map[uint64][]*big.Int
+	mutex  sync.Mutex
+}
+
+func (c *gasPriceCache) get(number uint64) ([]*big.Int, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	prices, ok := c.blocks[number]
+	return prices, ok
+}
+
+// add caches the prices of the block, dropping the blocks too far behind
+// the latest one to ever be sampled again.
+func (c *gasPriceCache) add(number uint64, prices []*big.Int, latest uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.blocks == nil {
+		c.blocks = map[uint64][]*big.Int{}
+	}
+	c.blocks[number] = prices
+	for n := range c.blocks {
+		if n+MaxGasPriceBlocks <= latest {
+			delete(c.blocks, n)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func blockWithGasPrices(prices ...int64) models.BlockWithTransactions {
+	block := models.BlockWithTransactions{}
+	for _, p := range prices {
+		block.Transactions = append(block.Transactions, models.BlockTransaction{
+			Hash:     cltest.NewHash(),
+			GasPrice: hexutil.Big(*big.NewInt(p)),
+		})
+	}
+	return block
+}
+
+func TestTxManager_GasPrice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		strategy  strpkg.GasPriceStrategy
+		mock      func(*cltest.EthMock)
+		want      *big.Int
+		wantError bool
+	}{
+		{"default", strpkg.GasPriceStrategy{}, func(*cltest.EthMock) {}, big.NewInt(20000000000), false},
+		{"fixed",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPriceFixed, Price: assets.NewEth(30000000000)},
+			func(*cltest.EthMock) {},
+			big.NewInt(30000000000), false},
+		{"fixed above maximum",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPriceFixed, Price: assets.NewEth(900000000000)},
+			func(*cltest.EthMock) {},
+			big.NewInt(500000000000), false},
+		{"network",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPriceNetwork},
+			func(m *cltest.EthMock) { m.Register("eth_gasPrice", "0x6fc23ac00") },
+			big.NewInt(30000000000), false},
+		{"network error",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPriceNetwork},
+			func(m *cltest.EthMock) { m.RegisterError("eth_gasPrice", "unavailable") },
+			nil, true},
+		{"percentile",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPricePercentile, Percentile: 60, Blocks: 2},
+			func(m *cltest.EthMock) {
+				m.Register("eth_blockNumber", utils.Uint64ToHex(100))
+				m.Register("eth_getBlockByNumber", blockWithGasPrices(5, 1, 4))
+				m.Register("eth_getBlockByNumber", blockWithGasPrices(3, 2))
+			},
+			big.NewInt(3), false},
+		{"percentile of empty blocks",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPricePercentile, Percentile: 60, Blocks: 1},
+			func(m *cltest.EthMock) {
+				m.Register("eth_blockNumber", utils.Uint64ToHex(100))
+				m.Register("eth_getBlockByNumber", blockWithGasPrices())
+			},
+			big.NewInt(20000000000), false},
+		{"percentile out of range",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPricePercentile, Percentile: 101},
+			func(*cltest.EthMock) {},
+			nil, true},
+		{"percentile over too many blocks",
+			strpkg.GasPriceStrategy{Strategy: strpkg.GasPricePercentile, Percentile: 60, Blocks: 101},
+			func(*cltest.EthMock) {},
+			nil, true},
+		{"unknown strategy",
+			strpkg.GasPriceStrategy{Strategy: "auction"},
+			func(*cltest.EthMock) {},
+			nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore()
+			defer cleanup()
+			ethMock := cltest.MockEthOnStore(store)
+			test.mock(ethMock)
+
+			price, err := store.TxManager.GasPrice(test.strategy)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.want, price)
+			}
+			assert.True(t, ethMock.AllCalled())
+		})
+	}
+}
+
+func TestTxManager_GasPrice_CachesBlocks(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+	ethMock := cltest.MockEthOnStore(store)
+	strategy := strpkg.GasPriceStrategy{Strategy: strpkg.GasPricePercentile, Percentile: 100, Blocks: 2}
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(100))
+	ethMock.Register("eth_getBlockByNumber", blockWithGasPrices(1))
+	ethMock.Register("eth_getBlockByNumber", blockWithGasPrices(2))
+	price, err := store.TxManager.GasPrice(strategy)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), price)
+	assert.True(t, ethMock.AllCalled())
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(100))
+	price, err = store.TxManager.GasPrice(strategy)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), price)
+	assert.True(t, ethMock.AllCalled())
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(101))
+	ethMock.Register("eth_getBlockByNumber", blockWithGasPrices(3),
+		func(_ interface{}, data ...interface{}) error {
+			assert.Equal(t, utils.Uint64ToHex(101), data[0].([]interface{})[0])
+			return nil
+		})
+	price, err = store.TxManager.GasPrice(strategy)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(3), price)
+	assert.True(t, ethMock.AllCalled())
+}
@@ -44,13 +44,12 @@ func (ks *KeyStore) Unlock(phrase string) error {
 	return nil
 }
 
-// SignTx uses the unlocked account to sign the given transaction.
-func (ks *KeyStore) SignTx(tx *types.Transaction, chainID uint64) (*types.Transaction, error) {
-	account, err := ks.GetAccount()
-	if err != nil {
-		return nil, err
-	}
-
+// SignTx uses the given unlocked account to sign the given transaction.
+func (ks *KeyStore) SignTx(
+	account accounts.Account,
+	tx *types.Transaction,
+	chainID uint64,
+) (*types.Transaction, error) {
 	return ks.KeyStore.SignTx(
 		account,
 		tx, big.NewInt(int64(chainID)),
@@ -26,10 +26,16 @@ const (
 	// RunStatusPendingBridge is used for when a run is waiting on the completion
 	// of another event.
 	RunStatusPendingBridge = RunStatus("pending_bridge")
+	// RunStatusPendingRetry is used for when a run is waiting to retry a
+	// task whose last attempt failed.
+	RunStatusPendingRetry = RunStatus("pending_retry")
 	// RunStatusErrored is used for when a run has errored and will not complete.
 	RunStatusErrored = RunStatus("errored")
 	// RunStatusCompleted is used for when a run has successfully completed execution.
 	RunStatusCompleted = RunStatus("completed")
+	// RunStatusCancelled is used for when a run was stopped before completing
+	// and will not be resumed.
+	RunStatusCancelled = RunStatus("cancelled")
 )
 
 // Unstarted returns true if the status is the initial state.
@@ -37,6 +43,11 @@ func (s RunStatus) Unstarted() bool {
 	return s == RunStatusUnstarted
 }
 
+// InProgress returns true if the status is in_progress.
+func (s RunStatus) InProgress() bool {
+	return s == RunStatusInProgress
+}
+
 // PendingBridge returns true if the status is pending_bridge.
 func (s RunStatus) PendingBridge() bool {
 	return s == RunStatusPendingBridge
@@ -47,6 +58,11 @@ func (s RunStatus) PendingConfirmations() bool {
 	return s == RunStatusPendingConfirmations
 }
 
+// PendingRetry returns true if the status is pending_retry.
+func (s RunStatus) PendingRetry() bool {
+	return s == RunStatusPendingRetry
+}
+
 // Completed returns true if the status is RunStatusCompleted.
 func (s RunStatus) Completed() bool {
 	return s == RunStatusCompleted
@@ -57,19 +73,25 @@ func (s RunStatus) Errored() bool {
 	return s == RunStatusErrored
 }
 
-// Pending returns true if the status is pending external or confirmations.
+// Cancelled returns true if the status is RunStatusCancelled.
+func (s RunStatus) Cancelled() bool {
+	return s == RunStatusCancelled
+}
+
+// Pending returns true if the status is pending external, confirmations or
+// a retry.
 func (s RunStatus) Pending() bool {
-	return s.PendingBridge() || s.PendingConfirmations()
+	return s.PendingBridge() || s.PendingConfirmations() || s.PendingRetry()
 }
 
 // Finished returns true if the status is final and can't be changed.
 func (s RunStatus) Finished() bool {
-	return s.Completed() || s.Errored()
+	return s.Completed() || s.Errored() || s.Cancelled()
 }
 
 // Runnable returns true if the status is ready to be run.
 func (s RunStatus) Runnable() bool {
-	return !s.Errored() && !s.Pending()
+	return !s.Errored() && !s.Cancelled() && !s.Pending()
 }
 
 // CanStart returns true if the run is ready to begin processed.
@@ -262,6 +284,31 @@ func (t *Time) HumanString() string {
 	return utils.ISO8601UTC(t.Time)
 }
 
+// Duration holds a time.Duration that is encoded in JSON as a string such
+// as "1m30s".
+type Duration struct {
+	time.Duration
+}
+
+// UnmarshalJSON parses the duration string stored in JSON-encoded data.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("Duration: %v", err)
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// MarshalJSON returns the duration as a JSON string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Duration.String())
+}
+
 // Cron holds the string that will represent the spec of the cron-job.
 // It uses 6 fields to represent the seconds (1), minutes (2), hours (3),
 // day of the month (4), month (5), and day of the week (6).
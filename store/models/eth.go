@@ -3,6 +3,7 @@ package models
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,15 +12,17 @@ import (
 )
 
 // Tx contains fields necessary for an Ethereum transaction with
-// an additional field for the TxAttempt.
+// an additional field for the TxAttempt. Cancelled is set once an attempt
+// cancelling the transaction has been confirmed in its place.
 type Tx struct {
-	ID       uint64         `storm:"id,increment,index"`
-	From     common.Address `storm:"index"`
-	To       common.Address
-	Data     []byte
-	Nonce    uint64 `storm:"index"`
-	Value    *big.Int
-	GasLimit uint64
+	ID        uint64         `storm:"id,increment,index"`
+	From      common.Address `storm:"index"`
+	To        common.Address
+	Data      []byte
+	Nonce     uint64 `storm:"index"`
+	Value     *big.Int
+	GasLimit  uint64
+	Cancelled bool
 	TxAttempt
 }
 
@@ -39,12 +42,17 @@ func (tx *Tx) EthTx(gasPrice *big.Int) *types.Transaction {
 // TxAttempt is used for keeping track of transactions that
 // have been written to the Ethereum blockchain. This makes
 // it so that if the network is busy, a transaction can be
-// resubmitted with a higher GasPrice.
+// resubmitted with a higher GasPrice. Failed is set once another
+// transaction has taken the attempt's nonce. Cancel is set on attempts that
+// transfer nothing from the account to itself instead of sending the
+// transaction.
 type TxAttempt struct {
 	Hash      common.Hash `storm:"id,unique"`
 	TxID      uint64      `storm:"index"`
 	GasPrice  *big.Int
 	Confirmed bool
+	Failed    bool
+	Cancel    bool
 	Hex       string
 	SentAt    uint64
 }
@@ -131,6 +139,19 @@ func (h BlockHeader) ToIndexableBlockNumber() *IndexableBlockNumber {
 	return NewIndexableBlockNumber(h.Number.ToInt(), h.Hash())
 }
 
+// BlockWithTransactions represents a block along with the transactions it
+// contains, as returned when asking the node for full transaction objects.
+type BlockWithTransactions struct {
+	Number       hexutil.Big        `json:"number"`
+	Transactions []BlockTransaction `json:"transactions"`
+}
+
+// BlockTransaction holds the fields of a block's transaction the node uses.
+type BlockTransaction struct {
+	Hash     common.Hash `json:"hash"`
+	GasPrice hexutil.Big `json:"gasPrice"`
+}
+
 // IndexableBlockNumber represents a BlockNumber, BlockHash and the number of Digits in the BlockNumber
 type IndexableBlockNumber struct {
 	Number hexutil.Big `json:"number" storm:"id,unique"`
@@ -180,6 +201,69 @@ func (l *IndexableBlockNumber) NextInt() *big.Int {
 	return new(big.Int).Add(l.ToInt(), big.NewInt(1))
 }
 
+// LogCheckpoint records which logs a subscription has handled, so that each
+// log is handled once, even across restarts. Every log before BlockNumber has
+// been handled, and Handled holds the block number of each log handled from
+// BlockNumber onwards, by LogID.
+type LogCheckpoint struct {
+	ID          string `storm:"id"`
+	BlockNumber uint64
+	Handled     map[string]uint64
+}
+
+// NewLogCheckpoint returns an empty checkpoint with the given ID.
+func NewLogCheckpoint(id string) *LogCheckpoint {
+	return &LogCheckpoint{ID: id, Handled: map[string]uint64{}}
+}
+
+// LogID identifies a log by its transaction hash and its index in the block,
+// as several logs can share a block or a transaction.
+func LogID(log types.Log) string {
+	return fmt.Sprintf("%v/%v", log.TxHash.Hex(), log.Index)
+}
+
+// IsHandled returns true if the log has already been handled, either because
+// it is before the checkpoint's BlockNumber or because it was marked handled.
+func (c *LogCheckpoint) IsHandled(log types.Log) bool {
+	if log.BlockNumber < c.BlockNumber {
+		return true
+	}
+	_, handled := c.Handled[LogID(log)]
+	return handled
+}
+
+// MarkHandled records the log as handled.
+func (c *LogCheckpoint) MarkHandled(log types.Log) {
+	c.Handled[LogID(log)] = log.BlockNumber
+}
+
+// Advance moves BlockNumber forward to the given block, forgetting the logs
+// handled before it.
+func (c *LogCheckpoint) Advance(blockNumber uint64) {
+	if blockNumber <= c.BlockNumber {
+		return
+	}
+	c.BlockNumber = blockNumber
+	for id, bn := range c.Handled {
+		if bn < blockNumber {
+			delete(c.Handled, id)
+		}
+	}
+}
+
+// Rewind moves BlockNumber back to the given block, forgetting the logs
+// handled from it onwards so that they are handled again.
+func (c *LogCheckpoint) Rewind(blockNumber uint64) {
+	if blockNumber < c.BlockNumber {
+		c.BlockNumber = blockNumber
+	}
+	for id, bn := range c.Handled {
+		if bn >= blockNumber {
+			delete(c.Handled, id)
+		}
+	}
+}
+
 // EthSubscription should implement Err() <-chan error and Unsubscribe()
 type EthSubscription interface {
 	Err() <-chan error
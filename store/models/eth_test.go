@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
@@ -135,3 +136,36 @@ func TestModels_IndexableBlockNumber_NextInt(t *testing.T) {
 		})
 	}
 }
+
+func TestLogCheckpoint_AdvanceAndRewind(t *testing.T) {
+	t.Parallel()
+
+	checkpoint := models.NewLogCheckpoint("initiator")
+	txHash := cltest.NewHash()
+	first := types.Log{TxHash: txHash, Index: 0, BlockNumber: 10}
+	second := types.Log{TxHash: txHash, Index: 1, BlockNumber: 10}
+	later := types.Log{TxHash: cltest.NewHash(), Index: 0, BlockNumber: 12}
+
+	checkpoint.MarkHandled(first)
+	checkpoint.MarkHandled(later)
+	assert.True(t, checkpoint.IsHandled(first))
+	assert.False(t, checkpoint.IsHandled(second))
+
+	checkpoint.Advance(11)
+	assert.Equal(t, uint64(11), checkpoint.BlockNumber)
+	assert.Len(t, checkpoint.Handled, 1)
+	assert.True(t, checkpoint.IsHandled(first))
+	assert.True(t, checkpoint.IsHandled(second))
+	assert.True(t, checkpoint.IsHandled(later))
+
+	checkpoint.Advance(5)
+	assert.Equal(t, uint64(11), checkpoint.BlockNumber)
+
+	checkpoint.Rewind(12)
+	assert.Equal(t, uint64(11), checkpoint.BlockNumber)
+	assert.False(t, checkpoint.IsHandled(later))
+
+	checkpoint.Rewind(8)
+	assert.Equal(t, uint64(8), checkpoint.BlockNumber)
+	assert.False(t, checkpoint.IsHandled(first))
+}
@@ -2,10 +2,12 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/utils"
 	"github.com/tidwall/gjson"
 	null "gopkg.in/guregu/null.v3"
@@ -14,13 +16,23 @@ import (
 // JobSpec is the definition for all the work to be carried out by the node
 // for a given contract. It contains the Initiators, Tasks (which are the
 // individual steps to be carried out), StartAt, EndAt, and CreatedAt fields.
+//
+// MinPayment is the least amount of LINK a request must pay for a run of the
+// job. When it is not set, it is the sum of the payments required by the
+// job's adapters and bridges.
+//
+// An archived job is kept along with its runs, but is no longer run. Each
+// update of a job makes a new Version of it, keeping the job's ID.
 type JobSpec struct {
-	ID         string      `json:"id" storm:"id,unique"`
-	Initiators []Initiator `json:"initiators"`
-	Tasks      []TaskSpec  `json:"tasks" storm:"inline"`
-	StartAt    null.Time   `json:"startAt" storm:"index"`
-	EndAt      null.Time   `json:"endAt" storm:"index"`
-	CreatedAt  Time        `json:"createdAt" storm:"index"`
+	ID         string       `json:"id" storm:"id,unique"`
+	Initiators []Initiator  `json:"initiators"`
+	Tasks      []TaskSpec   `json:"tasks" storm:"inline"`
+	StartAt    null.Time    `json:"startAt" storm:"index"`
+	EndAt      null.Time    `json:"endAt" storm:"index"`
+	CreatedAt  Time         `json:"createdAt" storm:"index"`
+	MinPayment *assets.Link `json:"minPayment,omitempty"`
+	ArchivedAt null.Time    `json:"archivedAt" storm:"index"`
+	Version    uint64       `json:"version"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -62,12 +74,64 @@ func (j JobSpec) NewRun(i Initiator) JobRun {
 	}
 
 	return JobRun{
-		ID:        jrid,
+		ID:         jrid,
+		JobID:      j.ID,
+		JobVersion: j.Version,
+		CreatedAt:  time.Now(),
+		TaskRuns:   taskRuns,
+		Initiator:  i,
+		Status:     RunStatusUnstarted,
+	}
+}
+
+// NewVersion returns the next version of the job, made of the initiators,
+// tasks, schedule and payment of the update. Initiators the job already had
+// keep their ID, so that those which have run or are watching for logs
+// carry on where they were.
+func (j JobSpec) NewVersion(update JobSpec) JobSpec {
+	next := update
+	next.ID = j.ID
+	next.CreatedAt = j.CreatedAt
+	next.ArchivedAt = j.ArchivedAt
+	next.Version = j.Version + 1
+
+	reused := map[int]bool{}
+	next.Initiators = make([]Initiator, len(update.Initiators))
+	for i, initr := range update.Initiators {
+		initr.ID = 0
+		initr.JobID = j.ID
+		initr.Ran = false
+		for _, prev := range j.Initiators {
+			if !reused[prev.ID] && prev.sameTrigger(initr) {
+				reused[prev.ID] = true
+				initr.ID = prev.ID
+				initr.Ran = prev.Ran
+				break
+			}
+		}
+		next.Initiators[i] = initr
+	}
+	return next
+}
+
+// JobSpecVersion records a version of a job, so that the parameters its runs
+// were made with remain known after the job is updated.
+type JobSpecVersion struct {
+	ID        string    `json:"id" storm:"id,unique"`
+	JobID     string    `json:"jobId" storm:"index"`
+	Version   uint64    `json:"version"`
+	Spec      JobSpec   `json:"spec"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewJobSpecVersion returns the record of the job's current version.
+func NewJobSpecVersion(j JobSpec) JobSpecVersion {
+	return JobSpecVersion{
+		ID:        fmt.Sprintf("%v/%v", j.ID, j.Version),
 		JobID:     j.ID,
+		Version:   j.Version,
+		Spec:      j,
 		CreatedAt: time.Now(),
-		TaskRuns:  taskRuns,
-		Initiator: i,
-		Status:    RunStatusUnstarted,
 	}
 }
 
@@ -113,6 +177,11 @@ func (j JobSpec) Ended(t time.Time) bool {
 	return t.After(j.EndAt.Time)
 }
 
+// Archived returns true if the job has been archived.
+func (j JobSpec) Archived() bool {
+	return j.ArchivedAt.Valid
+}
+
 // Started returns true if the job has started.
 func (j JobSpec) Started(t time.Time) bool {
 	if !j.StartAt.Valid {
@@ -170,13 +239,33 @@ func (i Initiator) IsLogInitiated() bool {
 	return i.Type == InitiatorEthLog || i.Type == InitiatorRunLog
 }
 
+// sameTrigger returns true if both initiators start runs on the same
+// occasions.
+func (i Initiator) sameTrigger(other Initiator) bool {
+	return i.Type == other.Type &&
+		i.Schedule == other.Schedule &&
+		i.Time.Equal(other.Time.Time) &&
+		i.Address == other.Address
+}
+
 // TaskSpec is the definition of work to be carried out. The
 // Type will be an adapter, and the Params will contain any
 // additional information that adapter would need to operate.
+//
+// Tasks run in the order they are listed unless any task in the job names
+// Parents, in which case the tasks form a graph: a task without Parents
+// receives the run's input, a task with one parent receives that parent's
+// result, and a task with several parents joins their results. A task only
+// runs when its Condition, a JSON path into its input, is truthy. Failed
+// requests are retried according to the task's Retry policy, if any.
 type TaskSpec struct {
-	Type          string `json:"type" storm:"index"`
-	Confirmations uint64 `json:"confirmations"`
-	Params        JSON   `json:"-"`
+	Type          string       `json:"type" storm:"index"`
+	Confirmations uint64       `json:"confirmations"`
+	ID            string       `json:"id,omitempty"`
+	Parents       []string     `json:"parents,omitempty"`
+	Condition     string       `json:"condition,omitempty"`
+	Retry         *RetryPolicy `json:"retry,omitempty"`
+	Params        JSON         `json:"-"`
 }
 
 // UnmarshalJSON parses the given input and updates the TaskSpec.
@@ -189,6 +278,10 @@ func (t *TaskSpec) UnmarshalJSON(input []byte) error {
 
 	t.Confirmations = aux.Confirmations
 	t.Type = strings.ToLower(aux.Type)
+	t.ID = aux.ID
+	t.Parents = aux.Parents
+	t.Condition = aux.Condition
+	t.Retry = aux.Retry
 	var params json.RawMessage
 	if err := json.Unmarshal(input, &params); err != nil {
 		return err
@@ -216,12 +309,67 @@ func (t TaskSpec) MarshalJSON() ([]byte, error) {
 	return json.Marshal(merged)
 }
 
+// DefaultRetryableStatusCodes are the HTTP status codes retried when a
+// RetryPolicy does not list its own.
+var DefaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504}
+
+// RetryPolicy determines how a task whose request failed is retried. The
+// delay before each retry doubles, starting from Backoff. Requests that
+// received no response are always retried, those that did only when their
+// status code is one of the StatusCodes.
+type RetryPolicy struct {
+	MaxAttempts uint64   `json:"maxAttempts"`
+	Backoff     Duration `json:"backoff"`
+	StatusCodes []int    `json:"statusCodes,omitempty"`
+}
+
+// ShouldRetry returns true if the failed result may be retried after the
+// given number of attempts.
+func (rp *RetryPolicy) ShouldRetry(result RunResult, attempts uint64) bool {
+	if rp == nil || !result.Retryable || attempts >= rp.MaxAttempts {
+		return false
+	}
+	if result.StatusCode == 0 {
+		return true
+	}
+
+	codes := rp.StatusCodes
+	if len(codes) == 0 {
+		codes = DefaultRetryableStatusCodes
+	}
+	for _, code := range codes {
+		if code == result.StatusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxRetryDelay is the longest a RetryPolicy waits between retries, however
+// many attempts have been made.
+const MaxRetryDelay = 24 * time.Hour
+
+// Delay returns how long to wait before the retry following the given
+// number of attempts, doubling with each attempt up to MaxRetryDelay.
+func (rp *RetryPolicy) Delay(attempts uint64) time.Duration {
+	delay := rp.Backoff.Duration
+	for i := uint64(1); i < attempts && delay > 0 && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > MaxRetryDelay {
+		return MaxRetryDelay
+	}
+	return delay
+}
+
 // BridgeType is used for external adapters and has fields for
-// the name of the adapter and its URL.
+// the name of the adapter and its URL. MinPayment is the LINK the node
+// requires for each task using the bridge.
 type BridgeType struct {
-	Name                 string `json:"name" storm:"id,unique"`
-	URL                  WebURL `json:"url"`
-	DefaultConfirmations uint64 `json:"defaultConfirmations"`
+	Name                 string       `json:"name" storm:"id,unique"`
+	URL                  WebURL       `json:"url"`
+	DefaultConfirmations uint64       `json:"defaultConfirmations"`
+	MinPayment           *assets.Link `json:"minPayment,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -241,7 +389,7 @@ func (bt *BridgeType) SetID(value string) error {
 }
 
 // UnmarshalJSON parses the given input and updates the BridgeType
-// Name and URL.
+// Name, URL and payment.
 func (bt *BridgeType) UnmarshalJSON(input []byte) error {
 	type Alias BridgeType
 	var aux Alias
@@ -251,5 +399,6 @@ func (bt *BridgeType) UnmarshalJSON(input []byte) error {
 	bt.Name = strings.ToLower(aux.Name)
 	bt.URL = aux.URL
 	bt.DefaultConfirmations = aux.DefaultConfirmations
+	bt.MinPayment = aux.MinPayment
 	return nil
 }
@@ -2,11 +2,14 @@ package models_test
 
 import (
 	"encoding/json"
+	"errors"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/adapters"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/assets"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/stretchr/testify/assert"
 	null "gopkg.in/guregu/null.v3"
@@ -26,6 +29,27 @@ func TestJobSpec_Save(t *testing.T) {
 	assert.Equal(t, initr.Schedule, j2.Initiators[0].Schedule)
 }
 
+func TestJobSpec_MinPayment(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	var j1 models.JobSpec
+	assert.NoError(t, json.Unmarshal([]byte(`{"minPayment":"1000","tasks":[{"type":"NoOp"}]}`), &j1))
+	assert.Equal(t, assets.NewLink(1000), j1.MinPayment)
+
+	j1.ID = "withpayment"
+	assert.NoError(t, store.SaveJob(&j1))
+	j2, err := store.FindJob(j1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, assets.NewLink(1000), j2.MinPayment)
+
+	var bt models.BridgeType
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"Priced","url":"https://bridge.example.com","minPayment":"200"}`), &bt))
+	assert.Equal(t, "priced", bt.Name)
+	assert.Equal(t, assets.NewLink(200), bt.MinPayment)
+}
+
 func TestJobSpec_NewRun(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore()
@@ -48,6 +72,42 @@ func TestJobSpec_NewRun(t *testing.T) {
 	assert.Equal(t, initr, run.Initiator)
 }
 
+func TestJobSpec_NewVersion(t *testing.T) {
+	t.Parallel()
+
+	job, _ := cltest.NewJobWithSchedule("1 * * * *")
+	job.Initiators = append(job.Initiators, models.Initiator{Type: models.InitiatorWeb})
+	job.Initiators[0].ID = 1
+	job.Initiators[0].JobID = job.ID
+	job.Initiators[0].Ran = true
+	job.Initiators[1].ID = 2
+	job.Initiators[1].JobID = job.ID
+	job.Tasks = []models.TaskSpec{cltest.NewTask("NoOp")}
+
+	update := cltest.NewJob()
+	update.Initiators = []models.Initiator{
+		{Type: models.InitiatorCron, Schedule: "1 * * * *"},
+		{Type: models.InitiatorCron, Schedule: "2 * * * *"},
+	}
+	update.Tasks = []models.TaskSpec{cltest.NewTask("NoOp", `{"confirmations":3}`)}
+
+	next := job.NewVersion(update)
+	assert.Equal(t, job.ID, next.ID)
+	assert.Equal(t, job.CreatedAt, next.CreatedAt)
+	assert.Equal(t, uint64(1), next.Version)
+	assert.Equal(t, update.Tasks, next.Tasks)
+
+	assert.Len(t, next.Initiators, 2)
+	assert.Equal(t, 1, next.Initiators[0].ID)
+	assert.True(t, next.Initiators[0].Ran)
+	assert.Equal(t, 0, next.Initiators[1].ID)
+	assert.Equal(t, job.ID, next.Initiators[1].JobID)
+
+	run := next.NewRun(next.Initiators[0])
+	assert.Equal(t, uint64(1), run.JobVersion)
+	assert.Equal(t, uint64(2), next.NewVersion(update).Version)
+}
+
 func TestJobEnded(t *testing.T) {
 	t.Parallel()
 
@@ -148,3 +208,48 @@ func TestTask_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	policy := &models.RetryPolicy{MaxAttempts: 3}
+	custom := &models.RetryPolicy{MaxAttempts: 3, StatusCodes: []int{404}}
+	failed := models.RunResult{}.WithRetryableError(errors.New("502"), 502)
+	noResponse := models.RunResult{}.WithRetryableError(errors.New("timeout"), 0)
+
+	tests := []struct {
+		name     string
+		policy   *models.RetryPolicy
+		result   models.RunResult
+		attempts uint64
+		want     bool
+	}{
+		{"no policy", nil, failed, 1, false},
+		{"default status code", policy, failed, 1, true},
+		{"no response", policy, noResponse, 2, true},
+		{"attempts exhausted", policy, failed, 3, false},
+		{"not retryable", policy, models.RunResult{}.WithError(errors.New("bad")), 1, false},
+		{"status code not listed", custom, failed, 1, false},
+		{"status code listed", custom, models.RunResult{}.WithRetryableError(errors.New("404"), 404), 1, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.policy.ShouldRetry(test.result, test.attempts))
+		})
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	var policy models.RetryPolicy
+	err := json.Unmarshal([]byte(`{"maxAttempts":4,"backoff":"10s"}`), &policy)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 10*time.Second, policy.Delay(1))
+	assert.Equal(t, 20*time.Second, policy.Delay(2))
+	assert.Equal(t, 40*time.Second, policy.Delay(3))
+	assert.Equal(t, models.MaxRetryDelay, policy.Delay(20))
+	assert.Equal(t, models.MaxRetryDelay, policy.Delay(math.MaxUint64))
+}
@@ -12,6 +12,9 @@ func (orm ORM) migrate() {
 	orm.initializeModel(&TxAttempt{})
 	orm.initializeModel(&BridgeType{})
 	orm.initializeModel(&IndexableBlockNumber{})
+	orm.initializeModel(&LogCheckpoint{})
+	orm.initializeModel(&RunRequest{})
+	orm.initializeModel(&JobSpecVersion{})
 }
 
 func (orm ORM) initializeModel(klass interface{}) {
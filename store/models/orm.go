@@ -1,10 +1,12 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/asdine/storm"
 	"github.com/asdine/storm/q"
@@ -12,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/smartcontractkit/chainlink/utils"
+	null "gopkg.in/guregu/null.v3"
 )
 
 // ORM contains the database object used by Chainlink.
@@ -80,16 +83,149 @@ func (orm *ORM) FindJobRun(id string) (JobRun, error) {
 	return jr, err
 }
 
+// ErrJobRunChanged is returned when a job run is not saved because it was
+// changed in the store since it was read.
+var ErrJobRunChanged = errors.New("job run was changed since it was read")
+
+// CancelJobRun moves the run and its unfinished tasks to the cancelled
+// status. The stored run is read within the same transaction, and the run is
+// only cancelled if its statuses are still those of the given run, so that a
+// run being executed at the same time is not cancelled from a stale copy.
+func (orm *ORM) CancelJobRun(jr JobRun) (JobRun, error) {
+	dbtx, err := orm.Begin(true)
+	if err != nil {
+		return jr, err
+	}
+	defer dbtx.Rollback()
+
+	var stored JobRun
+	if err := dbtx.One("ID", jr.ID, &stored); err != nil {
+		return jr, err
+	}
+	if !sameStatuses(stored, jr) {
+		return stored, ErrJobRunChanged
+	}
+
+	cancelled := stored.MarkCancelled()
+	if err := dbtx.Save(&cancelled); err != nil {
+		return jr, err
+	}
+	return cancelled, dbtx.Commit()
+}
+
+// RetryJobRun clears the errors of the run and its errored tasks so that it
+// is executed again, as of the given time. Like CancelJobRun, the run is only
+// retried if the stored run still has the statuses of the given run, so that
+// concurrent retries do not both execute it.
+func (orm *ORM) RetryJobRun(jr JobRun, at time.Time) (JobRun, error) {
+	dbtx, err := orm.Begin(true)
+	if err != nil {
+		return jr, err
+	}
+	defer dbtx.Rollback()
+
+	var stored JobRun
+	if err := dbtx.One("ID", jr.ID, &stored); err != nil {
+		return jr, err
+	}
+	if !sameStatuses(stored, jr) {
+		return stored, ErrJobRunChanged
+	}
+
+	retried := stored.MarkRetried(at)
+	if err := dbtx.Save(&retried); err != nil {
+		return jr, err
+	}
+	return retried, dbtx.Commit()
+}
+
+// SaveJobRun saves the run, unless the stored run has been cancelled since
+// it was read, in which case ErrJobRunChanged is returned.
+func (orm *ORM) SaveJobRun(jr *JobRun) error {
+	dbtx, err := orm.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Rollback()
+
+	var stored JobRun
+	err = dbtx.One("ID", jr.ID, &stored)
+	if err != nil && err != storm.ErrNotFound {
+		return err
+	}
+	if err == nil && stored.Status.Cancelled() && !jr.Status.Cancelled() {
+		return ErrJobRunChanged
+	}
+	if err := dbtx.Save(jr); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+func sameStatuses(a, b JobRun) bool {
+	if a.Status != b.Status || len(a.TaskRuns) != len(b.TaskRuns) {
+		return false
+	}
+	for i := range a.TaskRuns {
+		if a.TaskRuns[i].Status != b.TaskRuns[i].Status {
+			return false
+		}
+	}
+	return true
+}
+
+// FindRunRequest looks up a RunRequest by its request ID.
+func (orm *ORM) FindRunRequest(id string) (RunRequest, error) {
+	var rr RunRequest
+	err := orm.One("ID", id, &rr)
+	return rr, err
+}
+
+// DeleteRunRequestFor deletes the RunRequest that created the given run, if
+// any, so that the request can be run again.
+func (orm *ORM) DeleteRunRequestFor(runID string) error {
+	var rr RunRequest
+	err := orm.One("RunID", runID, &rr)
+	if err == storm.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return orm.DeleteStruct(&rr)
+}
+
 // InitBucket initializes buckets and indexes before saving an object.
 func (orm *ORM) InitBucket(model interface{}) error {
 	return orm.Init(model)
 }
 
-// Jobs fetches all jobs.
+// Jobs fetches all jobs that have not been archived.
 func (orm *ORM) Jobs() ([]JobSpec, error) {
-	var jobs []JobSpec
-	err := orm.All(&jobs)
-	return jobs, err
+	var all []JobSpec
+	if err := orm.All(&all); err != nil {
+		return nil, err
+	}
+	jobs := []JobSpec{}
+	for _, j := range all {
+		if !j.Archived() {
+			jobs = append(jobs, j)
+		}
+	}
+	return jobs, nil
+}
+
+// ArchiveJob marks the job with the given ID as archived at the given time,
+// keeping it and its runs.
+func (orm *ORM) ArchiveJob(id string, at time.Time) (JobSpec, error) {
+	job, err := orm.FindJob(id)
+	if err != nil {
+		return job, err
+	}
+	if job.Archived() {
+		return job, fmt.Errorf("job %v already archived", id)
+	}
+	job.ArchivedAt = null.TimeFrom(at)
+	return job, orm.Save(&job)
 }
 
 // JobRunsFor fetches all JobRuns with a given Job ID,
@@ -117,6 +253,66 @@ func (orm *ORM) SaveJob(job *JobSpec) error {
 	}
 	defer tx.Rollback()
 
+	if err := saveJob(tx, job); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateInitiators saves the job's initiators that have not been saved yet,
+// giving them their IDs, without saving the job itself.
+func (orm *ORM) CreateInitiators(job *JobSpec) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	for i := range job.Initiators {
+		if job.Initiators[i].ID != 0 {
+			continue
+		}
+		job.Initiators[i].JobID = job.ID
+		if err := tx.Save(&job.Initiators[i]); err != nil {
+			return fmt.Errorf("error saving Job Initiators: %+v", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// UpdateJob saves the next version of the previous job, deleting the
+// initiators it no longer has. Both versions are kept in the job's version
+// history.
+func (orm *ORM) UpdateJob(previous JobSpec, next *JobSpec) error {
+	tx, err := orm.Begin(true)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %+v", err)
+	}
+	defer tx.Rollback()
+
+	if err := saveJobVersion(tx, previous, false); err != nil {
+		return err
+	}
+	if err := saveJob(tx, next); err != nil {
+		return err
+	}
+
+	kept := map[int]bool{}
+	for _, initr := range next.Initiators {
+		kept[initr.ID] = true
+	}
+	for i, initr := range previous.Initiators {
+		if kept[initr.ID] {
+			continue
+		}
+		if err := tx.DeleteStruct(&previous.Initiators[i]); err != nil && err != storm.ErrNotFound {
+			return fmt.Errorf("error deleting Job Initiators: %+v", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func saveJob(tx storm.Node, job *JobSpec) error {
 	for i := range job.Initiators {
 		job.Initiators[i].JobID = job.ID
 		if err := tx.Save(&job.Initiators[i]); err != nil {
@@ -126,7 +322,38 @@ func (orm *ORM) SaveJob(job *JobSpec) error {
 	if err := tx.Save(job); err != nil {
 		return fmt.Errorf("error saving job: %+v", err)
 	}
-	return tx.Commit()
+	return saveJobVersion(tx, *job, true)
+}
+
+// saveJobVersion records the job's current version, replacing the spec of
+// an existing record only if asked to.
+func saveJobVersion(tx storm.Node, job JobSpec, replace bool) error {
+	version := NewJobSpecVersion(job)
+	var existing JobSpecVersion
+	err := tx.One("ID", version.ID, &existing)
+	if err == nil {
+		if !replace {
+			return nil
+		}
+		version.CreatedAt = existing.CreatedAt
+	} else if err != storm.ErrNotFound {
+		return fmt.Errorf("error finding job version: %+v", err)
+	}
+
+	if err := tx.Save(&version); err != nil {
+		return fmt.Errorf("error saving job version: %+v", err)
+	}
+	return nil
+}
+
+// JobSpecVersions returns the recorded versions of the job, oldest first.
+func (orm *ORM) JobSpecVersions(jobID string) ([]JobSpecVersion, error) {
+	versions := []JobSpecVersion{}
+	err := orm.Select(q.Eq("JobID", jobID)).OrderBy("Version").Find(&versions)
+	if err == storm.ErrNotFound {
+		return []JobSpecVersion{}, nil
+	}
+	return versions, err
 }
 
 // SaveCreationHeight stores the JobRun in the database with the given
@@ -138,7 +365,7 @@ func (orm *ORM) SaveCreationHeight(jr JobRun, bn *IndexableBlockNumber) (JobRun,
 
 	dup := bn.Number
 	jr.CreationHeight = &dup
-	return jr, orm.Save(&jr)
+	return jr, orm.SaveJobRun(&jr)
 }
 
 // JobRunsWithStatus returns the JobRuns which have the passed statuses.
@@ -183,6 +410,7 @@ func (orm *ORM) ConfirmTx(tx *Tx, txat *TxAttempt) error {
 
 	txat.Confirmed = true
 	tx.TxAttempt = *txat
+	tx.Cancelled = txat.Cancel
 	if err := dbtx.Save(tx); err != nil {
 		return err
 	}
@@ -192,6 +420,70 @@ func (orm *ORM) ConfirmTx(tx *Tx, txat *TxAttempt) error {
 	return dbtx.Commit()
 }
 
+// MarkTxFailed updates the database for the given transaction and all of
+// its attempts to show that they were superseded by another transaction
+// with the same nonce.
+func (orm *ORM) MarkTxFailed(tx *Tx) error {
+	attempts, err := orm.AttemptsFor(tx.ID)
+	if err != nil {
+		return err
+	}
+
+	dbtx, err := orm.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Rollback()
+
+	for _, txat := range attempts {
+		txat.Failed = true
+		if err := dbtx.Save(&txat); err != nil {
+			return err
+		}
+	}
+	tx.Failed = true
+	if err := dbtx.Save(tx); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// FindTxByAttempt returns the transaction with an attempt of the given
+// hash.
+func (orm *ORM) FindTxByAttempt(hash common.Hash) (*Tx, error) {
+	txat := &TxAttempt{}
+	if err := orm.One("Hash", hash, txat); err != nil {
+		return nil, err
+	}
+	tx := &Tx{}
+	if err := orm.One("ID", txat.TxID, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// UnconfirmedTxs returns the transactions that are neither confirmed nor
+// failed.
+func (orm *ORM) UnconfirmedTxs() ([]Tx, error) {
+	txs := []Tx{}
+	err := orm.Select(q.Eq("Confirmed", false), q.Eq("Failed", false)).Find(&txs)
+	if err == storm.ErrNotFound {
+		return txs, nil
+	}
+	return txs, err
+}
+
+// TxsFrom returns the transactions sent from the given address, ordered by
+// nonce.
+func (orm *ORM) TxsFrom(address common.Address) ([]Tx, error) {
+	txs := []Tx{}
+	err := orm.Select(q.Eq("From", address)).OrderBy("Nonce").Find(&txs)
+	if err == storm.ErrNotFound {
+		return txs, nil
+	}
+	return txs, err
+}
+
 // AttemptsFor returns the Transaction Attempts (TxAttempt) for a
 // given Transaction ID (TxID).
 func (orm *ORM) AttemptsFor(id uint64) ([]TxAttempt, error) {
@@ -208,6 +500,25 @@ func (orm *ORM) AddAttempt(
 	tx *Tx,
 	etx *types.Transaction,
 	blkNum uint64,
+) (*TxAttempt, error) {
+	return orm.addAttempt(tx, etx, blkNum, false)
+}
+
+// AddCancelAttempt creates a new attempt cancelling the transaction, whose
+// own fields are left as they were, and sets it as the transaction's latest.
+func (orm *ORM) AddCancelAttempt(
+	tx *Tx,
+	etx *types.Transaction,
+	blkNum uint64,
+) (*TxAttempt, error) {
+	return orm.addAttempt(tx, etx, blkNum, true)
+}
+
+func (orm *ORM) addAttempt(
+	tx *Tx,
+	etx *types.Transaction,
+	blkNum uint64,
+	cancel bool,
 ) (*TxAttempt, error) {
 	hex, err := utils.EncodeTxToHex(etx)
 	if err != nil {
@@ -216,6 +527,7 @@ func (orm *ORM) AddAttempt(
 	attempt := &TxAttempt{
 		Hash:     etx.Hash(),
 		GasPrice: etx.GasPrice(),
+		Cancel:   cancel,
 		Hex:      hex,
 		TxID:     tx.ID,
 		SentAt:   blkNum,
@@ -282,6 +594,36 @@ func (orm *ORM) MarkRan(i *Initiator) error {
 	return dbtx.Commit()
 }
 
+// FindLogCheckpoint returns the checkpoint with the given ID, or an empty
+// one if none has been saved yet.
+func (orm *ORM) FindLogCheckpoint(id string) (*LogCheckpoint, error) {
+	checkpoint := NewLogCheckpoint(id)
+	err := orm.One("ID", id, checkpoint)
+	if err == storm.ErrNotFound {
+		return NewLogCheckpoint(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+	if checkpoint.Handled == nil {
+		checkpoint.Handled = map[string]uint64{}
+	}
+	return checkpoint, nil
+}
+
+// RewindLogCheckpoint moves the checkpoint with the given ID back to the
+// given block number, if it has been saved.
+func (orm *ORM) RewindLogCheckpoint(id string, blockNumber uint64) error {
+	checkpoint := &LogCheckpoint{}
+	err := orm.One("ID", id, checkpoint)
+	if err == storm.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	checkpoint.Rewind(blockNumber)
+	return orm.Save(checkpoint)
+}
+
 // DatabaseAccessError is an error that occurs during database access.
 type DatabaseAccessError struct {
 	msg string
@@ -2,13 +2,16 @@ package models_test
 
 import (
 	"encoding/hex"
+	"errors"
 	"math/big"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/asdine/storm"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
@@ -293,3 +296,208 @@ func TestMarkRan(t *testing.T) {
 	assert.NoError(t, store.One("ID", initr.ID, &ir))
 	assert.True(t, ir.Ran)
 }
+
+func TestORM_FindLogCheckpoint(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	checkpoint, err := store.FindLogCheckpoint("initiator")
+	assert.Nil(t, err)
+	assert.Equal(t, "initiator", checkpoint.ID)
+	assert.Equal(t, uint64(0), checkpoint.BlockNumber)
+
+	checkpoint.Advance(20)
+	checkpoint.MarkHandled(types.Log{TxHash: cltest.NewHash(), BlockNumber: 21})
+	assert.Nil(t, store.Save(checkpoint))
+
+	found, err := store.FindLogCheckpoint("initiator")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(20), found.BlockNumber)
+	assert.Len(t, found.Handled, 1)
+}
+
+func TestORM_RewindLogCheckpoint(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	assert.Nil(t, store.RewindLogCheckpoint("missing", 5))
+	_, err := store.FindLogCheckpoint("missing")
+	assert.Nil(t, err)
+
+	checkpoint := models.NewLogCheckpoint("initiator")
+	checkpoint.Advance(20)
+	log := types.Log{TxHash: cltest.NewHash(), BlockNumber: 21}
+	checkpoint.MarkHandled(log)
+	assert.Nil(t, store.Save(checkpoint))
+
+	assert.Nil(t, store.RewindLogCheckpoint("initiator", 15))
+	found, err := store.FindLogCheckpoint("initiator")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(15), found.BlockNumber)
+	assert.False(t, found.IsHandled(log))
+}
+
+func TestORM_DeleteRunRequestFor(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	rr := models.RunRequest{ID: cltest.NewHash().Hex(), RunID: "run"}
+	assert.Nil(t, store.Save(&rr))
+	found, err := store.FindRunRequest(rr.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "run", found.RunID)
+
+	assert.Nil(t, store.DeleteRunRequestFor("other"))
+	_, err = store.FindRunRequest(rr.ID)
+	assert.Nil(t, err)
+
+	assert.Nil(t, store.DeleteRunRequestFor("run"))
+	_, err = store.FindRunRequest(rr.ID)
+	assert.Equal(t, storm.ErrNotFound, err)
+}
+
+func TestORM_ArchiveJob(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	j1, initr := cltest.NewJobWithWebInitiator()
+	assert.Nil(t, store.SaveJob(&j1))
+	j2 := cltest.NewJob()
+	assert.Nil(t, store.SaveJob(&j2))
+	run := j1.NewRun(initr)
+	assert.Nil(t, store.Save(&run))
+
+	archivedAt := time.Now()
+	archived, err := store.ArchiveJob(j1.ID, archivedAt)
+	assert.Nil(t, err)
+	assert.True(t, archived.Archived())
+
+	found, err := store.FindJob(j1.ID)
+	assert.Nil(t, err)
+	assert.True(t, found.Archived())
+	assert.True(t, archivedAt.Equal(found.ArchivedAt.Time))
+	runs, err := store.JobRunsFor(j1.ID)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 1)
+
+	jobs, err := store.Jobs()
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, j2.ID, jobs[0].ID)
+
+	_, err = store.ArchiveJob(j1.ID, archivedAt)
+	assert.Error(t, err)
+	_, err = store.ArchiveJob("bogus", archivedAt)
+	assert.Equal(t, storm.ErrNotFound, err)
+}
+
+func TestORM_UpdateJob(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, _ := cltest.NewJobWithSchedule("1 * * * *")
+	job.Initiators = append(job.Initiators, models.Initiator{Type: models.InitiatorWeb})
+	assert.Nil(t, store.SaveJob(&job))
+	kept, removed := job.Initiators[0], job.Initiators[1]
+
+	update := cltest.NewJob()
+	update.Initiators = []models.Initiator{
+		{Type: models.InitiatorCron, Schedule: "1 * * * *"},
+		{Type: models.InitiatorCron, Schedule: "2 * * * *"},
+	}
+	next := job.NewVersion(update)
+	assert.Nil(t, store.UpdateJob(job, &next))
+
+	found, err := store.FindJob(job.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), found.Version)
+	assert.Len(t, found.Initiators, 2)
+	assert.Equal(t, kept.ID, found.Initiators[0].ID)
+	assert.NotEqual(t, 0, found.Initiators[1].ID)
+
+	var initrs []models.Initiator
+	assert.Nil(t, store.Where("JobID", job.ID, &initrs))
+	ids := []int{}
+	for _, initr := range initrs {
+		ids = append(ids, initr.ID)
+	}
+	assert.NotContains(t, ids, removed.ID)
+	assert.Contains(t, ids, kept.ID)
+
+	versions, err := store.JobSpecVersions(job.ID)
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, uint64(0), versions[0].Version)
+	assert.Len(t, versions[0].Spec.Initiators, 2)
+	assert.Equal(t, models.InitiatorWeb, versions[0].Spec.Initiators[1].Type)
+	assert.Equal(t, uint64(1), versions[1].Version)
+	assert.Equal(t, models.Cron("2 * * * *"), versions[1].Spec.Initiators[1].Schedule)
+}
+
+func TestORM_JobSpecVersions_None(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	versions, err := store.JobSpecVersions("bogus")
+	assert.Nil(t, err)
+	assert.Empty(t, versions)
+}
+
+func TestORM_CancelJobRun(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask("noop")}
+	jr := job.NewRun(initr)
+	jr.Status = models.RunStatusPendingConfirmations
+	assert.NoError(t, store.Save(&jr))
+
+	changed := jr
+	changed.Status = models.RunStatusInProgress
+	assert.NoError(t, store.SaveJobRun(&changed))
+	_, err := store.CancelJobRun(jr)
+	assert.Equal(t, models.ErrJobRunChanged, err)
+
+	cancelled, err := store.CancelJobRun(changed)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, cancelled.Status)
+	assert.Equal(t, models.RunStatusCancelled, cancelled.TaskRuns[0].Status)
+
+	assert.Equal(t, models.ErrJobRunChanged, store.SaveJobRun(&changed))
+	found, err := store.FindJobRun(jr.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusCancelled, found.Status)
+}
+
+func TestORM_RetryJobRun(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore()
+	defer cleanup()
+
+	job, initr := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask("noop")}
+	jr := job.NewRun(initr)
+	jr = jr.ApplyResult(models.RunResult{}.WithError(errors.New("failed")))
+	jr.TaskRuns[0] = jr.TaskRuns[0].ApplyResult(jr.Result)
+	assert.NoError(t, store.Save(&jr))
+
+	retried, err := store.RetryJobRun(jr, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusUnstarted, retried.Status)
+	assert.Equal(t, models.RunStatusUnstarted, retried.TaskRuns[0].Status)
+	assert.Equal(t, uint64(1), retried.Retries)
+
+	_, err = store.RetryJobRun(jr, time.Now())
+	assert.Equal(t, models.ErrJobRunChanged, err)
+	found, err := store.FindJobRun(jr.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), found.Retries)
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/tidwall/gjson"
 	null "gopkg.in/guregu/null.v3"
@@ -16,6 +17,7 @@ import (
 type JobRun struct {
 	ID             string       `json:"id" storm:"id,unique"`
 	JobID          string       `json:"jobId" storm:"index"`
+	JobVersion     uint64       `json:"jobVersion"`
 	Result         RunResult    `json:"result" storm:"inline"`
 	Status         RunStatus    `json:"status" storm:"index"`
 	TaskRuns       []TaskRun    `json:"taskRuns" storm:"inline"`
@@ -23,6 +25,8 @@ type JobRun struct {
 	CompletedAt    null.Time    `json:"completedAt"`
 	Initiator      Initiator    `json:"initiator"`
 	CreationHeight *hexutil.Big `json:"creationHeight"`
+	Retries        uint64       `json:"retries"`
+	RetriedAt      null.Time    `json:"retriedAt"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -63,7 +67,7 @@ func (jr JobRun) UnfinishedTaskRuns() []TaskRun {
 	for _, tr := range jr.TaskRuns {
 		if tr.Status.Completed() {
 			unfinished = unfinished[1:]
-		} else if tr.Status.Errored() {
+		} else if tr.Status.Errored() || tr.Status.Cancelled() {
 			return []TaskRun{}
 		} else {
 			return unfinished
@@ -78,6 +82,86 @@ func (jr JobRun) NextTaskRun() TaskRun {
 	return jr.UnfinishedTaskRuns()[0]
 }
 
+// TaskRunParents returns the indexes of the TaskRuns whose results feed into
+// the TaskRun at index i. When no task in the run names parents, each task
+// depends on the one listed before it.
+func (jr JobRun) TaskRunParents(i int) []int {
+	if !jr.isGraph() {
+		if i == 0 {
+			return []int{}
+		}
+		return []int{i - 1}
+	}
+
+	parents := []int{}
+	for _, id := range jr.TaskRuns[i].Task.Parents {
+		for j, tr := range jr.TaskRuns[:i] {
+			if tr.Task.ID == id {
+				parents = append(parents, j)
+				break
+			}
+		}
+	}
+	return parents
+}
+
+// ReadyTaskRuns returns the indexes of the TaskRuns that have not finished
+// and whose parents have all completed.
+func (jr JobRun) ReadyTaskRuns() []int {
+	ready := []int{}
+	for i, tr := range jr.TaskRuns {
+		if tr.Status.Finished() {
+			continue
+		}
+		completed := true
+		for _, p := range jr.TaskRunParents(i) {
+			completed = completed && jr.TaskRuns[p].Status.Completed()
+		}
+		if completed {
+			ready = append(ready, i)
+		}
+	}
+	return ready
+}
+
+// PendingBridgeTaskRuns returns the indexes of the TaskRuns waiting on a
+// bridge.
+func (jr JobRun) PendingBridgeTaskRuns() []int {
+	pending := []int{}
+	for i, tr := range jr.TaskRuns {
+		if tr.Status.PendingBridge() {
+			pending = append(pending, i)
+		}
+	}
+	return pending
+}
+
+// LatestTaskRun returns the TaskRun that determines the JobRun's result:
+// the first errored TaskRun, otherwise the first pending TaskRun, otherwise
+// the last TaskRun, which ends the task graph.
+func (jr JobRun) LatestTaskRun() TaskRun {
+	for _, tr := range jr.TaskRuns {
+		if tr.Status.Errored() {
+			return tr
+		}
+	}
+	for _, tr := range jr.TaskRuns {
+		if tr.Status.Pending() {
+			return tr
+		}
+	}
+	return jr.TaskRuns[len(jr.TaskRuns)-1]
+}
+
+func (jr JobRun) isGraph() bool {
+	for _, tr := range jr.TaskRuns {
+		if len(tr.Task.Parents) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Runnable checks that the number of confirmations have passed since the
 // job's creation height to determine if the JobRun can be started. Returns
 // true for non-JobSubscriber (runlog & ethlog) initiators.
@@ -92,6 +176,15 @@ func (jr JobRun) Runnable(currentHeight *IndexableBlockNumber, minConfs uint64)
 	return diff.Cmp(min) >= 0
 }
 
+// Orphaned returns true if the JobRun was created on a block after the given
+// common ancestor, meaning its creation block is no longer canonical.
+func (jr JobRun) Orphaned(ancestor *IndexableBlockNumber) bool {
+	if jr.CreationHeight == nil || ancestor == nil {
+		return false
+	}
+	return jr.CreationHeight.ToInt().Cmp(ancestor.ToInt()) > 0
+}
+
 // ApplyResult updates the JobRun's Result and Status
 func (jr JobRun) ApplyResult(result RunResult) JobRun {
 	jr.Result = result
@@ -111,13 +204,75 @@ func (jr JobRun) MarkCompleted() JobRun {
 	return jr
 }
 
+// MarkErrored sets the JobRun and its unfinished TaskRuns to errored with
+// the given error.
+func (jr JobRun) MarkErrored(err error) JobRun {
+	for i, tr := range jr.TaskRuns {
+		if !tr.Status.Finished() {
+			jr.TaskRuns[i] = tr.ApplyResult(tr.Result.WithError(err))
+		}
+	}
+	return jr.ApplyResult(jr.Result.WithError(err))
+}
+
+// MarkCancelled sets the JobRun and its unfinished TaskRuns to cancelled.
+func (jr JobRun) MarkCancelled() JobRun {
+	for i, tr := range jr.TaskRuns {
+		if !tr.Status.Finished() {
+			jr.TaskRuns[i].Status = RunStatusCancelled
+			jr.TaskRuns[i].Result.Status = RunStatusCancelled
+		}
+	}
+	jr.Status = RunStatusCancelled
+	jr.Result.Status = RunStatusCancelled
+	return jr
+}
+
+// MarkRetried clears the error of the JobRun and of its errored TaskRuns,
+// marking them unstarted so that they are executed again, and records the
+// retry. Retried TaskRuns start from the results of their parents, or from
+// their own result when they have none.
+func (jr JobRun) MarkRetried(at time.Time) JobRun {
+	for i, tr := range jr.TaskRuns {
+		if tr.Status.Errored() {
+			jr.TaskRuns[i] = tr.clearError()
+		}
+	}
+	jr.Status = RunStatusUnstarted
+	jr.Result.Status = RunStatusUnstarted
+	jr.Result.ErrorMessage = null.String{}
+	jr.Retries++
+	jr.RetriedAt = null.Time{Time: at, Valid: true}
+	return jr
+}
+
+// RunRequest records a request for a run made through a RunLog, so that the
+// same request is never run twice. Its ID is the request ID of the Oracle
+// RunRequest event, derived from the requester's external ID, and the log it
+// was received in is kept alongside the run created for it.
+type RunRequest struct {
+	ID          string      `json:"id" storm:"id,unique"`
+	JobID       string      `json:"jobId" storm:"index"`
+	RunID       string      `json:"runId" storm:"index"`
+	TxHash      common.Hash `json:"txHash"`
+	LogIndex    uint        `json:"logIndex"`
+	BlockNumber uint64      `json:"blockNumber"`
+	CreatedAt   time.Time   `json:"createdAt"`
+}
+
 // TaskRun stores the Task and represents the status of the
 // Task to be ran.
 type TaskRun struct {
-	ID     string    `json:"id" storm:"id,unique"`
-	Result RunResult `json:"result"`
-	Status RunStatus `json:"status"`
-	Task   TaskSpec  `json:"task"`
+	ID         string    `json:"id" storm:"id,unique"`
+	Input      JSON      `json:"input"`
+	Result     RunResult `json:"result"`
+	Status     RunStatus `json:"status"`
+	Task       TaskSpec  `json:"task"`
+	Skipped    bool      `json:"skipped,omitempty"`
+	Attempts   uint64    `json:"attempts,omitempty"`
+	RetryAt    null.Time `json:"retryAt"`
+	StartedAt  null.Time `json:"startedAt"`
+	FinishedAt null.Time `json:"finishedAt"`
 }
 
 // String returns info on the TaskRun as "ID,Type,Status,Result".
@@ -159,6 +314,15 @@ func (tr TaskRun) ApplyResult(result RunResult) TaskRun {
 	return tr
 }
 
+func (tr TaskRun) clearError() TaskRun {
+	tr.Status = RunStatusUnstarted
+	tr.Result.Status = RunStatusUnstarted
+	tr.Result.ErrorMessage = null.String{}
+	tr.RetryAt = null.Time{}
+	tr.FinishedAt = null.Time{}
+	return tr
+}
+
 // MarkCompleted marks the task's status as completed.
 func (tr TaskRun) MarkCompleted() TaskRun {
 	tr.Status = RunStatusCompleted
@@ -166,6 +330,37 @@ func (tr TaskRun) MarkCompleted() TaskRun {
 	return tr
 }
 
+// MarkInProgress marks the task as started at the given time, recording the
+// input it was started with so that it can be resumed.
+func (tr TaskRun) MarkInProgress(input RunResult, at time.Time) TaskRun {
+	tr.Status = RunStatusInProgress
+	tr.Input = input.Data
+	tr.Result = input
+	tr.Result.Status = RunStatusInProgress
+	tr.StartedAt = null.TimeFrom(at)
+	return tr
+}
+
+// MarkSkipped completes the task without running it, passing the given
+// input through as its result.
+func (tr TaskRun) MarkSkipped(input RunResult) TaskRun {
+	tr.Skipped = true
+	tr.Input = input.Data
+	tr.Result = input
+	return tr.MarkCompleted()
+}
+
+// MarkPendingRetry records a failed attempt and marks the task to be run
+// again with the given input at the given time.
+func (tr TaskRun) MarkPendingRetry(input RunResult, at time.Time) TaskRun {
+	tr.Attempts++
+	tr.RetryAt = null.TimeFrom(at)
+	tr.Result = input
+	tr.Status = RunStatusPendingRetry
+	tr.Result.Status = RunStatusPendingRetry
+	return tr
+}
+
 // MarkPendingConfirmations marks the task's status as blocked.
 func (tr TaskRun) MarkPendingConfirmations() TaskRun {
 	tr.Status = RunStatusPendingConfirmations
@@ -181,6 +376,8 @@ type RunResult struct {
 	Status       RunStatus   `json:"status"`
 	ErrorMessage null.String `json:"error"`
 	Amount       *big.Int    `json:"amount,omitempty"`
+	Retryable    bool        `json:"retryable,omitempty"`
+	StatusCode   int         `json:"statusCode,omitempty"`
 }
 
 // WithValue returns a copy of the RunResult, overriding the "value" field of
@@ -203,6 +400,16 @@ func (rr RunResult) WithError(err error) RunResult {
 	return rr
 }
 
+// WithRetryableError returns a copy of the RunResult with the error of a
+// failed request that the task's RetryPolicy may retry. The statusCode is
+// that of the response, or 0 if none was received.
+func (rr RunResult) WithRetryableError(err error, statusCode int) RunResult {
+	rr = rr.WithError(err)
+	rr.Retryable = true
+	rr.StatusCode = statusCode
+	return rr
+}
+
 // MarkPendingBridge returns a copy of RunResult but with status set to pending_bridge.
 func (rr RunResult) MarkPendingBridge() RunResult {
 	rr.Status = RunStatusPendingBridge
@@ -282,7 +489,8 @@ func (rr RunResult) Merge(in RunResult) (RunResult, error) {
 // BridgeRunResult handles the parsing of RunResults from external adapters.
 type BridgeRunResult struct {
 	RunResult
-	ExternalPending bool `json:"pending"`
+	ExternalPending bool   `json:"pending"`
+	TaskRunID       string `json:"taskRunId"`
 }
 
 // UnmarshalJSON parses the given input and updates the BridgeRunResult in the
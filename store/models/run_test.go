@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
@@ -54,6 +55,85 @@ func TestJobRun_UnfinishedTaskRuns(t *testing.T) {
 	assert.Equal(t, jr.TaskRuns[1:], jr.UnfinishedTaskRuns())
 }
 
+func TestJobRun_MarkCancelled(t *testing.T) {
+	t.Parallel()
+
+	j, i := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		{Type: "NoOp"},
+		{Type: "NoOpPend"},
+		{Type: "NoOp"},
+	}
+	jr := j.NewRun(i)
+	jr.TaskRuns[0] = jr.TaskRuns[0].MarkCompleted()
+	jr.TaskRuns[1] = jr.TaskRuns[1].MarkPendingConfirmations()
+	jr = jr.ApplyResult(jr.TaskRuns[1].Result)
+
+	jr = jr.MarkCancelled()
+	assert.Equal(t, models.RunStatusCancelled, jr.Status)
+	assert.Equal(t, models.RunStatusCancelled, jr.Result.Status)
+	assert.Equal(t, models.RunStatusCompleted, jr.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusCancelled, jr.TaskRuns[1].Status)
+	assert.Equal(t, models.RunStatusCancelled, jr.TaskRuns[2].Status)
+	assert.True(t, jr.Status.Finished())
+	assert.False(t, jr.Status.CanStart())
+	assert.Len(t, jr.UnfinishedTaskRuns(), 0)
+}
+
+func TestJobRun_MarkRetried(t *testing.T) {
+	t.Parallel()
+
+	j, i := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		{Type: "NoOp"},
+		{Type: "NoOp"},
+		{Type: "NoOp"},
+	}
+	jr := j.NewRun(i)
+	jr.TaskRuns[0] = jr.TaskRuns[0].MarkCompleted()
+	jr = jr.MarkErrored(errors.New("unreachable"))
+	assert.Len(t, jr.UnfinishedTaskRuns(), 0)
+
+	retriedAt := time.Now()
+	jr = jr.MarkRetried(retriedAt)
+	assert.Equal(t, models.RunStatusUnstarted, jr.Status)
+	assert.False(t, jr.Result.HasError())
+	assert.Equal(t, uint64(1), jr.Retries)
+	assert.Equal(t, null.TimeFrom(retriedAt), jr.RetriedAt)
+	assert.Equal(t, models.RunStatusCompleted, jr.TaskRuns[0].Status)
+	for _, tr := range jr.TaskRuns[1:] {
+		assert.Equal(t, models.RunStatusUnstarted, tr.Status)
+		assert.False(t, tr.Result.HasError())
+	}
+	assert.Equal(t, jr.TaskRuns[1:], jr.UnfinishedTaskRuns())
+	assert.True(t, jr.Status.CanStart())
+}
+
+func TestJobRun_TaskRunParents(t *testing.T) {
+	t.Parallel()
+
+	j, i := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{{Type: "NoOp"}, {Type: "NoOp"}, {Type: "NoOp"}}
+	jr := j.NewRun(i)
+	assert.Equal(t, []int{}, jr.TaskRunParents(0))
+	assert.Equal(t, []int{1}, jr.TaskRunParents(2))
+	assert.Equal(t, []int{0}, jr.ReadyTaskRuns())
+
+	j.Tasks = []models.TaskSpec{
+		{Type: "NoOp", ID: "a"},
+		{Type: "NoOp", ID: "b"},
+		{Type: "NoOp", Parents: []string{"a", "b"}},
+	}
+	jr = j.NewRun(i)
+	assert.Equal(t, []int{}, jr.TaskRunParents(1))
+	assert.Equal(t, []int{0, 1}, jr.TaskRunParents(2))
+	assert.Equal(t, []int{0, 1}, jr.ReadyTaskRuns())
+
+	jr.TaskRuns[0] = jr.TaskRuns[0].MarkCompleted()
+	jr.TaskRuns[1] = jr.TaskRuns[1].MarkCompleted()
+	assert.Equal(t, []int{2}, jr.ReadyTaskRuns())
+}
+
 func TestTaskRun_Runnable(t *testing.T) {
 	t.Parallel()
 
@@ -86,6 +166,32 @@ func TestTaskRun_Runnable(t *testing.T) {
 	}
 }
 
+func TestJobRun_Orphaned(t *testing.T) {
+	t.Parallel()
+
+	job, initr := cltest.NewJobWithLogInitiator()
+	tests := []struct {
+		name           string
+		creationHeight *hexutil.Big
+		ancestor       *models.IndexableBlockNumber
+		want           bool
+	}{
+		{"creation nil", nil, cltest.IndexableBlockNumber(1), false},
+		{"ancestor nil", cltest.NewBigHexInt(2), nil, false},
+		{"creation before ancestor", cltest.NewBigHexInt(1), cltest.IndexableBlockNumber(2), false},
+		{"creation at ancestor", cltest.NewBigHexInt(2), cltest.IndexableBlockNumber(2), false},
+		{"creation after ancestor", cltest.NewBigHexInt(3), cltest.IndexableBlockNumber(2), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jr := job.NewRun(initr)
+			jr.CreationHeight = test.creationHeight
+			assert.Equal(t, test.want, jr.Orphaned(test.ancestor))
+		})
+	}
+}
+
 func TestTaskRun_Merge(t *testing.T) {
 	t.Parallel()
 
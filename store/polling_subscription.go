@@ -0,0 +1,199 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+)
+
+// defaultPollInterval is how often an Ethereum node without subscriptions
+// is polled when no interval is configured.
+const defaultPollInterval = 15 * time.Second
+
+// PollingSubscriber emulates the "newHeads" and "logs" subscriptions of an
+// Ethereum node that only answers plain JSON-RPC calls, such as one reached
+// over HTTP, by polling it for new blocks every Interval.
+type PollingSubscriber struct {
+	Caller
+	Interval time.Duration
+}
+
+// EthSubscribe starts polling for the given subscription, sending new block
+// headers or logs on the channel from the node's latest block onwards.
+func (ps PollingSubscriber) EthSubscribe(
+	ctx context.Context,
+	channel interface{},
+	args ...interface{},
+) (models.EthSubscription, error) {
+	if len(args) == 0 {
+		return nil, errors.New("missing subscription name")
+	}
+	chanVal := reflect.ValueOf(channel)
+	if chanVal.Kind() != reflect.Chan || chanVal.Type().ChanDir()&reflect.SendDir == 0 {
+		return nil, fmt.Errorf("subscription channel must be a writable channel, got %T", channel)
+	}
+
+	sub := &PollingSubscription{
+		caller:  ps.Caller,
+		channel: chanVal,
+		errors:  make(chan error, 1),
+		done:    make(chan struct{}),
+	}
+	switch args[0] {
+	case "newHeads":
+		sub.poll = sub.pollHeads
+	case "logs":
+		if len(args) < 2 {
+			return nil, errors.New("missing logs filter")
+		}
+		filter, ok := args[1].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unsupported logs filter %v", args[1])
+		}
+		sub.filter = filter
+		sub.poll = sub.pollLogs
+	default:
+		return nil, fmt.Errorf("unsupported subscription %v", args[0])
+	}
+
+	latest, err := sub.blockNumber()
+	if err != nil {
+		return nil, err
+	}
+	sub.latest = latest
+
+	interval := ps.Interval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	sub.wg.Add(1)
+	go sub.listen(ctx, interval)
+	return sub, nil
+}
+
+// PollingSubscription is a models.EthSubscription fed by polling the
+// Ethereum node. Like a subscription over websockets, it ends with an error
+// on Err when the node cannot be reached.
+type PollingSubscription struct {
+	caller  Caller
+	channel reflect.Value
+	filter  map[string]interface{}
+	poll    func() error
+	latest  uint64
+	errors  chan error
+	done    chan struct{}
+	wg      sync.WaitGroup
+	once    sync.Once
+}
+
+// Err returns the channel on which an error ending the subscription is sent.
+// It is closed on Unsubscribe.
+func (sub *PollingSubscription) Err() <-chan error {
+	return sub.errors
+}
+
+// Unsubscribe stops polling and closes the Err channel.
+func (sub *PollingSubscription) Unsubscribe() {
+	sub.once.Do(func() {
+		close(sub.done)
+		sub.wg.Wait()
+		close(sub.errors)
+	})
+}
+
+func (sub *PollingSubscription) listen(ctx context.Context, interval time.Duration) {
+	defer sub.wg.Done()
+	for {
+		select {
+		case <-sub.done:
+			return
+		case <-ctx.Done():
+			sub.errors <- ctx.Err()
+			return
+		case <-time.After(interval):
+			if err := sub.poll(); err != nil {
+				sub.errors <- err
+				return
+			}
+		}
+	}
+}
+
+// pollHeads sends the header of each block mined since the last poll. A
+// block the node does not return yet is asked for again on the next poll.
+func (sub *PollingSubscription) pollHeads() error {
+	latest, err := sub.blockNumber()
+	if err != nil {
+		return err
+	}
+	for number := sub.latest + 1; number <= latest; number++ {
+		header := reflect.New(reflect.PtrTo(sub.channel.Type().Elem()))
+		err := sub.caller.Call(header.Interface(), "eth_getBlockByNumber", hexutil.EncodeUint64(number), false)
+		if err != nil {
+			return err
+		}
+		if header.Elem().IsNil() {
+			return nil
+		}
+		if !sub.send(header.Elem().Elem()) {
+			return nil
+		}
+		sub.latest = number
+	}
+	return nil
+}
+
+// pollLogs sends the logs matching the filter in the blocks mined since the
+// last poll.
+func (sub *PollingSubscription) pollLogs() error {
+	latest, err := sub.blockNumber()
+	if err != nil {
+		return err
+	}
+	if latest <= sub.latest {
+		return nil
+	}
+
+	filter := map[string]interface{}{}
+	for k, v := range sub.filter {
+		filter[k] = v
+	}
+	filter["fromBlock"] = hexutil.EncodeUint64(sub.latest + 1)
+	filter["toBlock"] = hexutil.EncodeUint64(latest)
+	logs := reflect.New(reflect.SliceOf(sub.channel.Type().Elem()))
+	if err := sub.caller.Call(logs.Interface(), "eth_getLogs", filter); err != nil {
+		return err
+	}
+	for i := 0; i < logs.Elem().Len(); i++ {
+		if !sub.send(logs.Elem().Index(i)) {
+			return nil
+		}
+	}
+	sub.latest = latest
+	return nil
+}
+
+// send blocks until the value is received or the subscription ends,
+// returning false in the latter case.
+func (sub *PollingSubscription) send(value reflect.Value) bool {
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: sub.channel, Send: value},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.done)},
+	})
+	return chosen == 0
+}
+
+func (sub *PollingSubscription) blockNumber() (uint64, error) {
+	result := ""
+	if err := sub.caller.Call(&result, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return utils.HexToUint64(result)
+}
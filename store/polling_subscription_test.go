@@ -0,0 +1,89 @@
+package store_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollingSubscriber_NewHeads(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	ethMock := &cltest.EthMock{}
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(12))
+	ethMock.Register("eth_getBlockByNumber", &models.BlockHeader{Number: hexutil.Big(*big.NewInt(11))},
+		func(_ interface{}, data ...interface{}) error {
+			assert.Equal(t, "0xb", data[0].([]interface{})[0])
+			return nil
+		})
+	ethMock.Register("eth_getBlockByNumber", (*models.BlockHeader)(nil))
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(12))
+	ethMock.Register("eth_getBlockByNumber", &models.BlockHeader{Number: hexutil.Big(*big.NewInt(12))},
+		func(_ interface{}, data ...interface{}) error {
+			assert.Equal(t, "0xc", data[0].([]interface{})[0])
+			return nil
+		})
+
+	ps := strpkg.PollingSubscriber{Caller: ethMock, Interval: time.Millisecond}
+	eth := &strpkg.EthClient{CallerSubscriber: ps}
+	headers := make(chan models.BlockHeader)
+	sub, err := eth.SubscribeToNewHeads(headers)
+	assert.NoError(t, err)
+
+	assert.Equal(t, big.NewInt(11), (<-headers).Number.ToInt())
+	assert.Equal(t, big.NewInt(12), (<-headers).Number.ToInt())
+	g.Eventually(sub.Err()).Should(gomega.Receive(gomega.HaveOccurred()))
+	sub.Unsubscribe()
+	assert.True(t, ethMock.AllCalled())
+}
+
+func TestPollingSubscriber_Logs(t *testing.T) {
+	t.Parallel()
+
+	address := cltest.NewAddress()
+	ethMock := &cltest.EthMock{}
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(12))
+	ethMock.Register("eth_getLogs", []types.Log{{Address: address, BlockNumber: 11}, {Address: address, BlockNumber: 12}},
+		func(_ interface{}, data ...interface{}) error {
+			filter := data[0].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, "0xb", filter["fromBlock"])
+			assert.Equal(t, "0xc", filter["toBlock"])
+			return nil
+		})
+
+	ps := strpkg.PollingSubscriber{Caller: ethMock, Interval: time.Millisecond}
+	logs := make(chan types.Log)
+	sub, err := ps.EthSubscribe(context.Background(), logs, "logs", utils.ToFilterArg(ethereum.FilterQuery{Addresses: []common.Address{address}}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(11), (<-logs).BlockNumber)
+	assert.Equal(t, uint64(12), (<-logs).BlockNumber)
+	sub.Unsubscribe()
+	assert.True(t, ethMock.AllCalled())
+}
+
+func TestPollingSubscriber_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	ps := strpkg.PollingSubscriber{Caller: &cltest.EthMock{}}
+	_, err := ps.EthSubscribe(context.Background(), make(chan types.Log), "newPendingTransactions")
+	assert.Error(t, err)
+	_, err = ps.EthSubscribe(context.Background(), make(chan types.Log), "logs")
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -272,3 +273,117 @@ func (t TaskSpec) FriendlyParams() (string, string) {
 func FriendlyBigInt(n *big.Int) string {
 	return fmt.Sprintf("#%[1]v (0x%[1]x)", n)
 }
+
+// Tx holds a transaction sent by the node along with each of its attempts.
+// Hash is the hash of the latest attempt.
+type Tx struct {
+	Hash      common.Hash    `json:"hash"`
+	From      common.Address `json:"from"`
+	To        common.Address `json:"to"`
+	Nonce     uint64         `json:"nonce"`
+	Value     string         `json:"value"`
+	GasLimit  uint64         `json:"gasLimit"`
+	Confirmed bool           `json:"confirmed"`
+	Failed    bool           `json:"failed"`
+	Cancelled bool           `json:"cancelled"`
+	Attempts  []TxAttempt    `json:"attempts"`
+}
+
+// NewTx returns the presenter of the transaction and its attempts, oldest
+// attempt first.
+func NewTx(tx models.Tx, attempts []models.TxAttempt) Tx {
+	ptx := Tx{
+		Hash:      tx.Hash,
+		From:      tx.From,
+		To:        tx.To,
+		Nonce:     tx.Nonce,
+		Value:     "0",
+		GasLimit:  tx.GasLimit,
+		Confirmed: tx.Confirmed,
+		Failed:    tx.Failed,
+		Cancelled: tx.Cancelled,
+		Attempts:  make([]TxAttempt, len(attempts)),
+	}
+	if tx.Value != nil {
+		ptx.Value = tx.Value.String()
+	}
+	for i, a := range attempts {
+		ptx.Attempts[i] = TxAttempt{
+			Hash:      a.Hash,
+			GasPrice:  a.GasPrice.String(),
+			SentAt:    a.SentAt,
+			Confirmed: a.Confirmed,
+			Failed:    a.Failed,
+			Cancel:    a.Cancel,
+		}
+	}
+	sort.SliceStable(ptx.Attempts, func(i, j int) bool {
+		return ptx.Attempts[i].SentAt < ptx.Attempts[j].SentAt
+	})
+	return ptx
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (tx Tx) GetID() string {
+	return tx.Hash.Hex()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (tx Tx) GetName() string {
+	return "transactions"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (tx *Tx) SetID(value string) error {
+	tx.Hash = common.HexToHash(value)
+	return nil
+}
+
+// FriendlyStatus returns whether the transaction is pending, being
+// cancelled, confirmed, failed or cancelled.
+func (tx Tx) FriendlyStatus() string {
+	if tx.Cancelled && !tx.Failed {
+		return "cancelled"
+	}
+	status := txStatus(tx.Confirmed, tx.Failed)
+	if status == "pending" && tx.cancelling() {
+		return "cancelling"
+	}
+	return status
+}
+
+// cancelling returns true if the latest attempt cancels the transaction.
+func (tx Tx) cancelling() bool {
+	for _, a := range tx.Attempts {
+		if a.Hash == tx.Hash {
+			return a.Cancel
+		}
+	}
+	return false
+}
+
+// TxAttempt holds one signed version of a transaction, sent at the SentAt
+// block number.
+type TxAttempt struct {
+	Hash      common.Hash `json:"hash"`
+	GasPrice  string      `json:"gasPrice"`
+	SentAt    uint64      `json:"sentAt"`
+	Confirmed bool        `json:"confirmed"`
+	Failed    bool        `json:"failed"`
+	Cancel    bool        `json:"cancel"`
+}
+
+// FriendlyStatus returns whether the attempt is pending, confirmed or
+// failed.
+func (a TxAttempt) FriendlyStatus() string {
+	return txStatus(a.Confirmed, a.Failed)
+}
+
+func txStatus(confirmed, failed bool) string {
+	if confirmed {
+		return "confirmed"
+	} else if failed {
+		return "failed"
+	}
+	return "pending"
+}
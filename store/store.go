@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/coreos/bbolt"
@@ -36,15 +37,33 @@ type Dialer interface {
 	Dial(string) (CallerSubscriber, error)
 }
 
-// EthDialer is Dialer which accesses rpc urls
-type EthDialer struct{}
+// rpcPollingWrapper polls nodes reached over HTTP, which cannot push
+// subscriptions.
+type rpcPollingWrapper struct {
+	*rpc.Client
+	interval time.Duration
+}
+
+func (wrapper rpcPollingWrapper) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (models.EthSubscription, error) {
+	ps := PollingSubscriber{Caller: wrapper.Client, Interval: wrapper.interval}
+	return ps.EthSubscribe(ctx, channel, args...)
+}
+
+// EthDialer is Dialer which accesses rpc urls. Subscriptions to nodes reached
+// over HTTP are emulated by polling them every PollInterval.
+type EthDialer struct {
+	PollInterval time.Duration
+}
 
 // Dial will dial the given url and return a CallerSubscriber
-func (EthDialer) Dial(url string) (CallerSubscriber, error) {
+func (d EthDialer) Dial(url string) (CallerSubscriber, error) {
 	dialed, err := rpc.Dial(url)
 	if err != nil {
 		return nil, err
 	}
+	if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+		return rpcPollingWrapper{dialed, d.PollInterval}, nil
+	}
 	return rpcSubscriptionWrapper{dialed}, nil
 }
 
@@ -52,7 +71,7 @@ func (EthDialer) Dial(url string) (CallerSubscriber, error) {
 // it is not already present, otherwise it will use the existing db.bolt
 // file.
 func NewStore(config Config) *Store {
-	return NewStoreWithDialer(config, EthDialer{})
+	return NewStoreWithDialer(config, EthDialer{PollInterval: config.EthPollInterval.Duration})
 }
 
 // NewStoreWithDialer creates a new store with the given config and dialer
@@ -62,7 +81,7 @@ func NewStoreWithDialer(config Config, dialer Dialer) *Store {
 		logger.Fatal(err)
 	}
 	orm := initializeORM(config)
-	ethrpc, err := dialer.Dial(config.EthereumURL)
+	ethrpc, err := NewFailoverClient(dialer, config.EthereumURLs())
 	if err != nil {
 		logger.Fatal(err)
 	}
@@ -83,13 +102,18 @@ func NewStoreWithDialer(config Config, dialer Dialer) *Store {
 	return store
 }
 
-// Start initiates all of Store's dependencies including the TxManager.
+// Start initiates all of Store's dependencies including the TxManager,
+// activating every account in the KeyStore.
 func (s *Store) Start() error {
-	acc, err := s.KeyStore.GetAccount()
-	if err != nil {
+	if _, err := s.KeyStore.GetAccount(); err != nil {
 		return err
 	}
-	return s.TxManager.ActivateAccount(acc)
+	for _, acc := range s.KeyStore.Accounts() {
+		if err := s.TxManager.ActivateAccount(acc); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // AfterNower is an interface that fulfills the `After()` and `Now()`
@@ -12,82 +12,200 @@ import (
 	"github.com/smartcontractkit/chainlink/logger"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/utils"
+	"go.uber.org/multierr"
 )
 
 const defaultGasLimit uint64 = 500000
 
 // TxManager contains fields for the Ethereum client, the KeyStore,
 // the local Config for the application, and the database.
+//
+// Transactions are spread across the active accounts in turn, each account
+// managing its own nonce.
 type TxManager struct {
 	*EthClient
-	keyStore      *KeyStore
-	config        Config
-	orm           *models.ORM
-	activeAccount *ActiveAccount
+	keyStore       *KeyStore
+	config         Config
+	orm            *models.ORM
+	activeAccounts []*ActiveAccount
+	nextAccount    int
+	accountsMutex  sync.Mutex
+	gasPrices      gasPriceCache
+}
+
+// TxOptions holds the optional settings of a new transaction. Zero values
+// fall back to the defaults: the next active account, a gas limit of 500000,
+// no ETH value and the configured default gas price.
+type TxOptions struct {
+	From     common.Address
+	GasLimit uint64
+	Value    *big.Int
+	GasPrice GasPriceStrategy
 }
 
 // CreateTx signs and sends a transaction to the Ethereum blockchain.
 func (txm *TxManager) CreateTx(to common.Address, data []byte) (*models.Tx, error) {
-	if txm.activeAccount == nil {
-		return nil, errors.New("Must activate an account before creating a transaction")
+	return txm.CreateTxWithOptions(to, data, TxOptions{})
+}
+
+// CreateTxWithOptions signs and sends a transaction to the Ethereum
+// blockchain with the given gas limit, value and gas price strategy.
+func (txm *TxManager) CreateTxWithOptions(
+	to common.Address,
+	data []byte,
+	opts TxOptions,
+) (*models.Tx, error) {
+	account, err := txm.sendingAccount(opts.From)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		gasLimit = defaultGasLimit
+	}
+	value := big.NewInt(0)
+	if opts.Value != nil {
+		if opts.Value.Sign() < 0 {
+			return nil, fmt.Errorf("transaction value cannot be negative, got %v", opts.Value)
+		}
+		value = new(big.Int).Set(opts.Value)
 	}
 
 	blkNum, err := txm.GetBlockNumber()
 	if err != nil {
 		return nil, err
 	}
+	gasPrice, err := txm.GasPrice(opts.GasPrice)
+	if err != nil {
+		return nil, err
+	}
 
 	var tx *models.Tx
-	err = txm.activeAccount.GetAndIncrementNonce(func(nonce uint64) error {
-		tx, err = txm.orm.CreateTx(
-			txm.activeAccount.Address,
+	err = account.GetAndIncrementNonce(func(nonce uint64) error {
+		tx, err = txm.createTx(
+			account.Address,
 			nonce,
 			to,
 			data,
-			big.NewInt(0),
-			defaultGasLimit,
+			value,
+			gasLimit,
+			gasPrice,
+			blkNum,
 		)
-		if err != nil {
-			return err
-		}
+		return err
+	})
 
-		gasPrice := txm.config.EthGasPriceDefault
-		var txa *models.TxAttempt
-		txa, err = txm.createAttempt(tx, &gasPrice, blkNum)
-		if err != nil {
-			txm.orm.DeleteStruct(tx)
-			txm.orm.DeleteStruct(txa)
+	return tx, err
+}
 
-			return err
-		}
+// createTx saves a transaction with the given nonce and sends its first
+// attempt, deleting both again if the attempt could not be sent.
+func (txm *TxManager) createTx(
+	from common.Address,
+	nonce uint64,
+	to common.Address,
+	data []byte,
+	value *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	blkNum uint64,
+) (*models.Tx, error) {
+	tx, err := txm.orm.CreateTx(from, nonce, to, data, value, gasLimit)
+	if err != nil {
+		return nil, err
+	}
 
-		return nil
-	})
+	txa, err := txm.createAttempt(tx, gasPrice, blkNum, false)
+	if err != nil {
+		txm.orm.DeleteStruct(tx)
+		txm.orm.DeleteStruct(txa)
 
-	return tx, err
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// sendingAccount returns the active account with the given address, or the
+// next active account in turn when the address is empty.
+func (txm *TxManager) sendingAccount(from common.Address) (*ActiveAccount, error) {
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	if len(txm.activeAccounts) == 0 {
+		return nil, errors.New("Must activate an account before creating a transaction")
+	}
+	if from != (common.Address{}) {
+		for _, a := range txm.activeAccounts {
+			if a.Address == from {
+				return a, nil
+			}
+		}
+		return nil, fmt.Errorf("cannot send from %v, it is not an active account", from.Hex())
+	}
+
+	account := txm.activeAccounts[txm.nextAccount%len(txm.activeAccounts)]
+	txm.nextAccount = (txm.nextAccount + 1) % len(txm.activeAccounts)
+	return account, nil
 }
 
 // MeetsMinConfirmations returns true if the given transaction hash has been
 // confirmed on the blockchain.
 func (txm *TxManager) MeetsMinConfirmations(hash common.Hash) (bool, error) {
+	tx, err := txm.orm.FindTxByAttempt(hash)
+	if err != nil {
+		return false, err
+	}
+	if tx.Cancelled {
+		return false, fmt.Errorf("transaction %v was cancelled", hash.String())
+	}
+	if tx.Confirmed {
+		return true, nil
+	}
+	if tx.Failed {
+		return false, fmt.Errorf("transaction %v failed, its nonce %v was taken by another transaction", hash.String(), tx.Nonce)
+	}
+
 	blkNum, err := txm.GetBlockNumber()
 	if err != nil {
 		return false, err
 	}
-	attempts, err := txm.getAttempts(hash)
+	confirmed, err := txm.checkTx(tx, blkNum)
+	if confirmed && tx.Cancelled {
+		return false, fmt.Errorf("transaction %v was cancelled", hash.String())
+	}
+	return confirmed, err
+}
+
+// CheckUnconfirmed checks every unconfirmed transaction against the given
+// block number, confirming those mined deep enough and bumping the gas of
+// those pending for too long.
+func (txm *TxManager) CheckUnconfirmed(blkNum uint64) error {
+	txs, err := txm.orm.UnconfirmedTxs()
+	if err != nil {
+		return err
+	}
+
+	var merr error
+	for i := range txs {
+		_, err := txm.checkTx(&txs[i], blkNum)
+		merr = multierr.Append(merr, err)
+	}
+	return merr
+}
+
+func (txm *TxManager) checkTx(tx *models.Tx, blkNum uint64) (bool, error) {
+	attempts, err := txm.orm.AttemptsFor(tx.ID)
 	if err != nil {
 		return false, err
 	}
 	if len(attempts) == 0 {
 		return false, fmt.Errorf("Can only ensure transactions with attempts")
 	}
-	tx := models.Tx{}
-	if err := txm.orm.One("ID", attempts[0].TxID, &tx); err != nil {
-		return false, err
-	}
 
 	for _, txat := range attempts {
-		success, err := txm.checkAttempt(&tx, &txat, blkNum)
+		success, err := txm.checkAttempt(tx, &txat, blkNum)
 		if success {
 			return success, err
 		}
@@ -95,18 +213,28 @@ func (txm *TxManager) MeetsMinConfirmations(hash common.Hash) (bool, error) {
 	return false, nil
 }
 
+// createAttempt signs and sends a new attempt of the transaction at the
+// given gas price, either of the transaction itself or cancelling it.
 func (txm *TxManager) createAttempt(
 	tx *models.Tx,
 	gasPrice *big.Int,
 	blkNum uint64,
+	cancel bool,
 ) (a *models.TxAttempt, err error) {
 	etx := tx.EthTx(gasPrice)
-	etx, err = txm.keyStore.SignTx(etx, txm.config.ChainID)
+	if cancel {
+		etx = cancelEthTx(tx, gasPrice)
+	}
+	etx, err = txm.keyStore.SignTx(accounts.Account{Address: tx.From}, etx, txm.config.ChainID)
 	if err != nil {
 		return nil, err
 	}
 
-	a, err = txm.orm.AddAttempt(tx, etx, blkNum)
+	if cancel {
+		a, err = txm.orm.AddCancelAttempt(tx, etx, blkNum)
+	} else {
+		a, err = txm.orm.AddAttempt(tx, etx, blkNum)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -130,18 +258,6 @@ func (txm *TxManager) sendTransaction(tx *types.Transaction) error {
 	return err
 }
 
-func (txm *TxManager) getAttempts(hash common.Hash) ([]models.TxAttempt, error) {
-	attempt := &models.TxAttempt{}
-	if err := txm.orm.One("Hash", hash, attempt); err != nil {
-		return []models.TxAttempt{}, err
-	}
-	attempts, err := txm.orm.AttemptsFor(attempt.TxID)
-	if err != nil {
-		return []models.TxAttempt{}, err
-	}
-	return attempts, nil
-}
-
 func (txm *TxManager) checkAttempt(
 	tx *models.Tx,
 	txat *models.TxAttempt,
@@ -198,33 +314,71 @@ func (txm *TxManager) bumpGas(txat *models.TxAttempt, blkNum uint64) error {
 	if err := txm.orm.One("ID", txat.TxID, tx); err != nil {
 		return err
 	}
-	gasPrice := new(big.Int).Add(txat.GasPrice, &txm.config.EthGasBumpWei)
-	txat, err := txm.createAttempt(tx, gasPrice, blkNum)
+	if txat.GasPrice.Cmp(&txm.config.EthGasPriceMax) >= 0 {
+		logger.Warnw(fmt.Sprintf("Not bumping gas for transaction %v, already at the maximum gas price", txat.Hash.String()), "txat", txat)
+		return nil
+	}
+	gasPrice := txm.capGasPrice(new(big.Int).Add(txat.GasPrice, &txm.config.EthGasBumpWei))
+	txat, err := txm.createAttempt(tx, gasPrice, blkNum, txat.Cancel)
+	if err != nil {
+		return err
+	}
 	logger.Infow(fmt.Sprintf("Bumping gas to %v for transaction %v", gasPrice, txat.Hash.String()), "txat", txat)
-	return err
+	return nil
 }
 
-// GetActiveAccount returns a copy of the TxManager's active nonce managed
-// account.
+// GetActiveAccount returns a copy of the TxManager's first active nonce
+// managed account.
 func (txm *TxManager) GetActiveAccount() *ActiveAccount {
-	if txm.activeAccount == nil {
+	active := txm.GetActiveAccounts()
+	if len(active) == 0 {
 		return nil
 	}
-	return &ActiveAccount{
-		Account: txm.activeAccount.Account,
-		nonce:   txm.activeAccount.nonce,
+	return active[0]
+}
+
+// activeAccountsList returns the TxManager's active accounts themselves,
+// rather than copies.
+func (txm *TxManager) activeAccountsList() []*ActiveAccount {
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+	return append([]*ActiveAccount{}, txm.activeAccounts...)
+}
+
+// GetActiveAccounts returns copies of all of the TxManager's active nonce
+// managed accounts.
+func (txm *TxManager) GetActiveAccounts() []*ActiveAccount {
+	actives := txm.activeAccountsList()
+	copies := make([]*ActiveAccount, len(actives))
+	for i, a := range actives {
+		copies[i] = &ActiveAccount{
+			Account: a.Account,
+			nonce:   a.GetNonce(),
+		}
 	}
+	return copies
 }
 
 // ActivateAccount retrieves an account's nonce from the blockchain for client
-// side management in ActiveAccount.
+// side management in ActiveAccount, replacing any active account with the
+// same address.
 func (txm *TxManager) ActivateAccount(account accounts.Account) error {
 	nonce, err := txm.GetNonce(account.Address)
 	if err != nil {
 		return err
 	}
 
-	txm.activeAccount = &ActiveAccount{Account: account, nonce: nonce}
+	txm.accountsMutex.Lock()
+	defer txm.accountsMutex.Unlock()
+
+	activeAccount := &ActiveAccount{Account: account, nonce: nonce}
+	for i, a := range txm.activeAccounts {
+		if a.Address == account.Address {
+			txm.activeAccounts[i] = activeAccount
+			return nil
+		}
+	}
+	txm.activeAccounts = append(txm.activeAccounts, activeAccount)
 	return nil
 }
 
@@ -238,6 +392,8 @@ type ActiveAccount struct {
 
 // GetNonce returns the client side managed nonce.
 func (a *ActiveAccount) GetNonce() uint64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
 	return a.nonce
 }
 
@@ -3,6 +3,7 @@ package store_test
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -107,6 +108,63 @@ func TestTxManager_CreateTx_AttemptErrorDeletesTxAndDoesNotIncrementNonce(t *tes
 	ethMock.EventuallyAllCalled(t)
 }
 
+func TestTxManager_CreateTxWithOptions(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+
+	to := cltest.NewAddress()
+	data, err := hex.DecodeString("0000abcdef")
+	assert.NoError(t, err)
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(256))
+	assert.NoError(t, app.Start())
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+	ethMock.Register("eth_gasPrice", "0x6fc23ac00")
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash(),
+		func(_ interface{}, data ...interface{}) error {
+			rlp := data[0].([]interface{})[0].(string)
+			tx, err := utils.DecodeEthereumTx(rlp)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(100000), tx.Gas())
+			assert.Equal(t, big.NewInt(1000), tx.Value())
+			assert.Equal(t, big.NewInt(30000000000), tx.GasPrice())
+			return nil
+		})
+
+	a, err := manager.CreateTxWithOptions(to, data, strpkg.TxOptions{
+		GasLimit: 100000,
+		Value:    big.NewInt(1000),
+		GasPrice: strpkg.GasPriceStrategy{Strategy: strpkg.GasPriceNetwork},
+	})
+	assert.NoError(t, err)
+
+	tx := models.Tx{}
+	assert.NoError(t, store.One("ID", a.TxID, &tx))
+	assert.Equal(t, uint64(100000), tx.GasLimit)
+	assert.Equal(t, big.NewInt(1000), tx.Value)
+	assert.Equal(t, big.NewInt(30000000000), tx.GasPrice)
+
+	ethMock.EventuallyAllCalled(t)
+}
+
+func TestTxManager_CreateTxWithOptions_NegativeValue(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(256))
+	assert.NoError(t, app.Start())
+
+	_, err := app.Store.TxManager.CreateTxWithOptions(cltest.NewAddress(), []byte{}, strpkg.TxOptions{
+		Value: big.NewInt(-1),
+	})
+	assert.Error(t, err)
+}
+
 func TestTxManager_MeetsMinConfirmations_BeforeThreshold(t *testing.T) {
 	t.Parallel()
 
@@ -171,6 +229,35 @@ func TestTxManager_MeetsMinConfirmations_AtThreshold(t *testing.T) {
 	ethMock.EventuallyAllCalled(t)
 }
 
+func TestTxManager_MeetsMinConfirmations_BumpFailure(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	config := store.Config
+	txm := store.TxManager
+
+	sentAt := uint64(23456)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{})
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(sentAt+config.EthGasBumpThreshold))
+
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), sentAt)
+	attempts, err := store.AttemptsFor(tx.ID)
+	assert.NoError(t, err)
+
+	assert.NotPanics(t, func() {
+		confirmed, _ := txm.MeetsMinConfirmations(attempts[0].Hash)
+		assert.False(t, confirmed)
+	})
+	attempts, err = store.AttemptsFor(tx.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(attempts))
+
+	ethMock.EventuallyAllCalled(t)
+}
+
 func TestTxManager_MeetsMinConfirmations_confirmed(t *testing.T) {
 	t.Parallel()
 
@@ -243,6 +330,75 @@ func TestTxManager_ActivateAccount(t *testing.T) {
 	assert.Equal(t, uint64(0x2d0), aa.GetNonce())
 }
 
+func TestTxManager_ActivateAccount_Multiple(t *testing.T) {
+	t.Parallel()
+
+	ethMock := &cltest.EthMock{}
+	txm := &strpkg.TxManager{
+		EthClient: &strpkg.EthClient{CallerSubscriber: ethMock},
+	}
+	first := accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca754")}
+	second := accounts.Account{Address: common.HexToAddress("0x3cb8e3fd9d27e39a5e9e6852b0e96160061fd4ea")}
+
+	ethMock.Register("eth_getTransactionCount", `0x1`)
+	ethMock.Register("eth_getTransactionCount", `0x2`)
+	ethMock.Register("eth_getTransactionCount", `0x3`)
+	assert.NoError(t, txm.ActivateAccount(first))
+	assert.NoError(t, txm.ActivateAccount(second))
+	assert.NoError(t, txm.ActivateAccount(first))
+	ethMock.EventuallyAllCalled(t)
+
+	aas := txm.GetActiveAccounts()
+	assert.Equal(t, 2, len(aas))
+	assert.Equal(t, first.Address, aas[0].Address)
+	assert.Equal(t, uint64(3), aas[0].GetNonce())
+	assert.Equal(t, second.Address, aas[1].Address)
+	assert.Equal(t, uint64(2), aas[1].GetNonce())
+	assert.Equal(t, first.Address, txm.GetActiveAccount().Address)
+}
+
+func TestTxManager_CreateTx_MultipleAccounts(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+
+	_, err := store.KeyStore.NewAccount(cltest.Password)
+	assert.NoError(t, err)
+	assert.NoError(t, store.KeyStore.Unlock(cltest.Password))
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(10))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(20))
+	assert.NoError(t, app.Start())
+	active := manager.GetActiveAccounts()
+	assert.Equal(t, 2, len(active))
+
+	to := cltest.NewAddress()
+	senders := []common.Address{}
+	for i := 0; i < 3; i++ {
+		ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+		ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+		tx, err := manager.CreateTx(to, []byte{})
+		assert.NoError(t, err)
+		senders = append(senders, tx.From)
+	}
+	assert.Equal(t, []common.Address{active[0].Address, active[1].Address, active[0].Address}, senders)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	tx, err := manager.CreateTxWithOptions(to, []byte{}, strpkg.TxOptions{From: active[1].Address})
+	assert.NoError(t, err)
+	assert.Equal(t, active[1].Address, tx.From)
+	assert.Equal(t, uint64(21), tx.Nonce)
+
+	_, err = manager.CreateTxWithOptions(to, []byte{}, strpkg.TxOptions{From: cltest.NewAddress()})
+	assert.Error(t, err)
+
+	ethMock.EventuallyAllCalled(t)
+}
+
 func TestActiveAccount_GetAndIncrementNonce_YieldsCurrentNonceAndIncrements(t *testing.T) {
 	account := accounts.Account{Address: common.HexToAddress("0xbf4ed7b27f1d666546e30d74d50d173d20bca754")}
 	activeAccount := strpkg.ActiveAccount{
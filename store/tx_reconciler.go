@@ -0,0 +1,170 @@
+package store
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"go.uber.org/multierr"
+)
+
+// selfTransferGasLimit is the gas needed by a plain transfer of ETH, used
+// when filling nonce gaps.
+const selfTransferGasLimit uint64 = 21000
+
+// ReconcileNonces compares the transactions of each active account with the
+// account's nonce on chain and repairs what it finds:
+//
+// Unconfirmed transactions whose nonce was taken on chain by a transaction
+// the node did not send, at least TxMinConfirmations blocks ago, have their
+// attempts marked as failed. Pending transactions the Ethereum node no
+// longer knows of are sent again. Nonces left unused between the chain's and
+// the account's are filled with zero value transfers to the account itself.
+// Finally, the account's nonce is moved past every nonce already in use.
+func (txm *TxManager) ReconcileNonces() error {
+	var merr error
+	for _, a := range txm.activeAccountsList() {
+		merr = multierr.Append(merr, txm.reconcileAccount(a))
+	}
+	return merr
+}
+
+func (txm *TxManager) reconcileAccount(a *ActiveAccount) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	onChain, err := txm.GetNonce(a.Address)
+	if err != nil {
+		return err
+	}
+	txs, err := txm.orm.TxsFrom(a.Address)
+	if err != nil {
+		return err
+	}
+
+	var merr error
+	used := map[uint64]bool{}
+	next := a.nonce
+	if onChain > next {
+		next = onChain
+	}
+	for i := range txs {
+		tx := &txs[i]
+		used[tx.Nonce] = true
+		if tx.Nonce >= next {
+			next = tx.Nonce + 1
+		}
+		if tx.Confirmed || tx.Failed {
+			continue
+		}
+		if tx.Nonce < onChain {
+			merr = multierr.Append(merr, txm.failIfSuperseded(tx))
+		} else {
+			merr = multierr.Append(merr, txm.resendIfDropped(tx))
+		}
+	}
+
+	merr = multierr.Append(merr, txm.fillNonceGaps(a, onChain, next, used))
+	if next != a.nonce {
+		logger.Warnw(
+			fmt.Sprintf("Moving nonce of account %v from %v to %v", a.Address.Hex(), a.nonce, next),
+			"onChainNonce", onChain,
+		)
+		a.nonce = next
+	}
+	return merr
+}
+
+// failIfSuperseded marks the transaction as failed unless one of its
+// attempts was mined. The nonce must have been taken at least
+// TxMinConfirmations blocks ago, so that a receipt of the node's own attempt
+// that is not available yet does not fail the transaction; until then it is
+// checked again on the next reconciliation.
+func (txm *TxManager) failIfSuperseded(tx *models.Tx) error {
+	attempts, err := txm.orm.AttemptsFor(tx.ID)
+	if err != nil {
+		return err
+	}
+	for _, txat := range attempts {
+		receipt, err := txm.GetTxReceipt(txat.Hash)
+		if err != nil {
+			return err
+		}
+		if !receipt.Unconfirmed() {
+			return nil
+		}
+	}
+
+	latest, err := txm.GetBlockNumber()
+	if err != nil {
+		return err
+	}
+	minConfs := txm.config.TxMinConfirmations
+	if latest < minConfs {
+		return nil
+	}
+	settled, err := txm.GetNonceAt(tx.From, latest-minConfs)
+	if err != nil || tx.Nonce >= settled {
+		return err
+	}
+
+	logger.Warnw(fmt.Sprintf("Nonce %v of tx %v was taken by another transaction, marking it failed", tx.Nonce, tx.Hash.String()), "tx", tx)
+	return txm.orm.MarkTxFailed(tx)
+}
+
+// resendIfDropped sends the latest attempt of the transaction again if the
+// Ethereum node has dropped it.
+func (txm *TxManager) resendIfDropped(tx *models.Tx) error {
+	known, err := txm.GetTxByHash(tx.Hash)
+	if err != nil || known != nil {
+		return err
+	}
+
+	logger.Warnw(fmt.Sprintf("Resending dropped tx %v", tx.Hash.String()), "tx", tx)
+	_, err = txm.SendRawTx(tx.Hex)
+	return err
+}
+
+// fillNonceGaps sends zero value transfers to the account itself for each
+// nonce from onChain up to next that no transaction uses, so that the
+// transactions after the gap can be mined.
+func (txm *TxManager) fillNonceGaps(
+	a *ActiveAccount,
+	onChain uint64,
+	next uint64,
+	used map[uint64]bool,
+) error {
+	var gasPrice *big.Int
+	var blkNum uint64
+	for nonce := onChain; nonce < next; nonce++ {
+		if used[nonce] {
+			continue
+		}
+		if gasPrice == nil {
+			var err error
+			if blkNum, err = txm.GetBlockNumber(); err != nil {
+				return err
+			}
+			if gasPrice, err = txm.GasPrice(GasPriceStrategy{}); err != nil {
+				return err
+			}
+		}
+
+		logger.Warnw(fmt.Sprintf("Filling nonce gap %v of account %v", nonce, a.Address.Hex()))
+		_, err := txm.createTx(
+			a.Address,
+			nonce,
+			a.Address,
+			[]byte{},
+			big.NewInt(0),
+			selfTransferGasLimit,
+			gasPrice,
+			blkNum,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTxWithNonce(store *strpkg.Store, from common.Address, nonce uint64) *models.Tx {
+	tx := cltest.NewTx(from, 1)
+	tx.Nonce = nonce
+	if err := store.Save(tx); err != nil {
+		panic(err)
+	}
+	if _, err := store.AddAttempt(tx, tx.EthTx(big.NewInt(1)), 1); err != nil {
+		panic(err)
+	}
+	return tx
+}
+
+func TestTxManager_ReconcileNonces(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(12))
+	assert.NoError(t, app.Start())
+
+	superseded := createTxWithNonce(store, from, 8)
+	dropped := createTxWithNonce(store, from, 11)
+
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(10))
+	ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{})
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(9),
+		func(_ interface{}, data ...interface{}) error {
+			assert.Equal(t, utils.Uint64ToHex(23450), data[0].([]interface{})[1])
+			return nil
+		})
+	ethMock.Register("eth_getTransactionByHash", (*models.BlockTransaction)(nil))
+	ethMock.Register("eth_sendRawTransaction", dropped.Hash,
+		func(_ interface{}, data ...interface{}) error {
+			assert.Equal(t, dropped.Hex, data[0].([]interface{})[0])
+			return nil
+		})
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(23456))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+
+	assert.NoError(t, manager.ReconcileNonces())
+	ethMock.EventuallyAllCalled(t)
+
+	assert.NoError(t, store.One("ID", superseded.ID, superseded))
+	assert.True(t, superseded.Failed)
+	attempts, err := store.AttemptsFor(superseded.ID)
+	assert.NoError(t, err)
+	assert.True(t, attempts[0].Failed)
+
+	txs, err := store.TxsFrom(from)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(txs))
+	filler := txs[1]
+	assert.Equal(t, uint64(10), filler.Nonce)
+	assert.Equal(t, from, filler.To)
+	assert.Equal(t, big.NewInt(0), filler.Value)
+	assert.Equal(t, uint64(12), manager.GetActiveAccount().GetNonce())
+
+	_, err = manager.MeetsMinConfirmations(superseded.Hash)
+	assert.Error(t, err)
+}
+
+func TestTxManager_ReconcileNonces_MovesNoncePastUsed(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(5))
+	assert.NoError(t, app.Start())
+
+	createTxWithNonce(store, from, 5)
+
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(5))
+	ethMock.Register("eth_getTransactionByHash", &models.BlockTransaction{})
+
+	assert.NoError(t, manager.ReconcileNonces())
+	ethMock.EventuallyAllCalled(t)
+	assert.Equal(t, uint64(6), manager.GetActiveAccount().GetNonce())
+}
+
+func TestTxManager_ReconcileNonces_WaitsForSupersedingNonceToSettle(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(9))
+	assert.NoError(t, app.Start())
+
+	tx := createTxWithNonce(store, from, 8)
+
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(9))
+	ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{})
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(100))
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(8))
+
+	assert.NoError(t, manager.ReconcileNonces())
+	ethMock.EventuallyAllCalled(t)
+
+	assert.NoError(t, store.One("ID", tx.ID, tx))
+	assert.False(t, tx.Failed)
+	assert.Equal(t, uint64(9), manager.GetActiveAccount().GetNonce())
+}
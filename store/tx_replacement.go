@@ -0,0 +1,91 @@
+package store
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/smartcontractkit/chainlink/logger"
+	"github.com/smartcontractkit/chainlink/store/models"
+)
+
+// ReplaceTx sends a new attempt of the pending transaction with an attempt
+// of the given hash, signed for the same nonce at a higher gas price, so that
+// the transaction is mined sooner. Without a gas price, the latest attempt's
+// price is bumped by EthGasBumpWei. The new attempt is recorded alongside
+// the transaction's previous attempts, and still cancels the transaction if
+// the latest attempt did.
+func (txm *TxManager) ReplaceTx(hash common.Hash, gasPrice *big.Int) (*models.Tx, error) {
+	tx, err := txm.replaceableTx(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infow(fmt.Sprintf("Replacing tx %v", tx.Hash.String()), "tx", tx)
+	return tx, txm.sendReplacement(tx, gasPrice, tx.Cancel)
+}
+
+// CancelTx replaces the pending transaction with an attempt of the given
+// hash by a zero value transfer from its account to itself, signed for the
+// same nonce at a higher gas price. The transaction keeps its own fields, as
+// either attempt may still be mined. If the cancelling attempt is confirmed,
+// the transaction is marked cancelled and runs waiting on it error.
+func (txm *TxManager) CancelTx(hash common.Hash, gasPrice *big.Int) (*models.Tx, error) {
+	tx, err := txm.replaceableTx(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infow(fmt.Sprintf("Cancelling tx %v", tx.Hash.String()), "tx", tx)
+	return tx, txm.sendReplacement(tx, gasPrice, true)
+}
+
+// replaceableTx returns the transaction with an attempt of the given hash,
+// provided it is still pending.
+func (txm *TxManager) replaceableTx(hash common.Hash) (*models.Tx, error) {
+	tx, err := txm.orm.FindTxByAttempt(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Confirmed {
+		return nil, fmt.Errorf("transaction %v is already confirmed", hash.String())
+	}
+	if tx.Failed {
+		return nil, fmt.Errorf("transaction %v failed, its nonce %v was taken by another transaction", hash.String(), tx.Nonce)
+	}
+	return tx, nil
+}
+
+// sendReplacement signs and sends a new attempt of the transaction, or one
+// cancelling it, at the given gas price, capped at EthGasPriceMax, which must
+// be above that of the latest attempt.
+func (txm *TxManager) sendReplacement(tx *models.Tx, gasPrice *big.Int, cancel bool) error {
+	if gasPrice == nil {
+		gasPrice = new(big.Int).Add(tx.GasPrice, &txm.config.EthGasBumpWei)
+	}
+	gasPrice = txm.capGasPrice(gasPrice)
+	if gasPrice.Cmp(tx.GasPrice) <= 0 {
+		return fmt.Errorf("gas price %v must be above the latest attempt's %v", gasPrice, tx.GasPrice)
+	}
+
+	blkNum, err := txm.GetBlockNumber()
+	if err != nil {
+		return err
+	}
+	_, err = txm.createAttempt(tx, gasPrice, blkNum, cancel)
+	return err
+}
+
+// cancelEthTx creates a zero value transfer from the transaction's account to
+// itself for the transaction's nonce, ready to be signed.
+func cancelEthTx(tx *models.Tx, gasPrice *big.Int) *types.Transaction {
+	return types.NewTransaction(
+		tx.Nonce,
+		tx.From,
+		big.NewInt(0),
+		selfTransferGasLimit,
+		gasPrice,
+		[]byte{},
+	)
+}
@@ -0,0 +1,158 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/store"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxManager_ReplaceTx(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	assert.NoError(t, app.Start())
+
+	tx := createTxWithNonce(store, from, 3)
+	firstHash := tx.Hash
+
+	_, err := manager.ReplaceTx(firstHash, big.NewInt(1))
+	assert.Error(t, err)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	replaced, err := manager.ReplaceTx(firstHash, nil)
+	assert.NoError(t, err)
+	ethMock.EventuallyAllCalled(t)
+
+	bumped := new(big.Int).Add(big.NewInt(1), &store.Config.EthGasBumpWei)
+	assert.Equal(t, bumped, replaced.GasPrice)
+	assert.Equal(t, uint64(3), replaced.Nonce)
+	assert.NotEqual(t, firstHash, replaced.Hash)
+	attempts, err := store.AttemptsFor(tx.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(attempts))
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(11))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	gasPrice := big.NewInt(900000000000)
+	replaced, err = manager.ReplaceTx(firstHash, gasPrice)
+	assert.NoError(t, err)
+	ethMock.EventuallyAllCalled(t)
+	assert.Equal(t, &store.Config.EthGasPriceMax, replaced.GasPrice)
+}
+
+func TestTxManager_CancelTx(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	assert.NoError(t, app.Start())
+
+	tx := createTxWithNonce(store, from, 3)
+	original := *tx
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	cancelled, err := manager.CancelTx(tx.Hash, big.NewInt(2))
+	assert.NoError(t, err)
+	ethMock.EventuallyAllCalled(t)
+
+	assert.False(t, cancelled.Cancelled)
+	assert.True(t, cancelled.Cancel)
+	assert.Equal(t, big.NewInt(2), cancelled.GasPrice)
+	assert.NoError(t, store.One("ID", tx.ID, tx))
+	assert.False(t, tx.Cancelled)
+	assert.Equal(t, original.To, tx.To)
+	assert.Equal(t, original.Data, tx.Data)
+	assert.Equal(t, original.Value, tx.Value)
+	assert.Equal(t, original.GasLimit, tx.GasLimit)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(11))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	bumped, err := manager.ReplaceTx(tx.Hash, nil)
+	assert.NoError(t, err)
+	ethMock.EventuallyAllCalled(t)
+	assert.True(t, bumped.Cancel)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(30))
+	for i := 0; i < 3; i++ {
+		ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{
+			Hash:        cltest.NewHash(),
+			BlockNumber: cltest.BigHexInt(12),
+		}, receiptOnlyFor(bumped.Hash))
+	}
+	_, err = manager.MeetsMinConfirmations(original.Hash)
+	assert.Error(t, err)
+	assert.NoError(t, store.One("ID", tx.ID, tx))
+	assert.True(t, tx.Confirmed)
+	assert.True(t, tx.Cancelled)
+
+	_, err = manager.CancelTx(tx.Hash, nil)
+	assert.Error(t, err)
+}
+
+func TestTxManager_CancelTx_OriginalConfirmed(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	manager := store.TxManager
+	from := cltest.GetAccountAddress(store)
+
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	assert.NoError(t, app.Start())
+
+	tx := createTxWithNonce(store, from, 3)
+	originalHash := tx.Hash
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	_, err := manager.CancelTx(originalHash, big.NewInt(2))
+	assert.NoError(t, err)
+	ethMock.EventuallyAllCalled(t)
+
+	// Below the gas bump threshold of the cancelling attempt
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(12))
+	for i := 0; i < 2; i++ {
+		ethMock.Register("eth_getTransactionReceipt", strpkg.TxReceipt{
+			Hash:        originalHash,
+			BlockNumber: cltest.BigHexInt(5),
+		}, receiptOnlyFor(originalHash))
+	}
+	confirmed, err := manager.MeetsMinConfirmations(originalHash)
+	assert.NoError(t, err)
+	assert.True(t, confirmed)
+	assert.NoError(t, store.One("ID", tx.ID, tx))
+	assert.True(t, tx.Confirmed)
+	assert.False(t, tx.Cancelled)
+	assert.Equal(t, originalHash, tx.Hash)
+}
+
+// receiptOnlyFor returns a mock callback that leaves the receipt empty,
+// meaning unconfirmed, unless it was requested for the given hash.
+func receiptOnlyFor(hash common.Hash) func(interface{}, ...interface{}) error {
+	return func(result interface{}, data ...interface{}) error {
+		requested := data[0].([]interface{})[0].(string)
+		if requested != hash.String() {
+			*result.(*strpkg.TxReceipt) = strpkg.TxReceipt{}
+		}
+		return nil
+	}
+}
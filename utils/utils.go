@@ -173,12 +173,17 @@ func HexConcat(strs ...string) string {
 
 // RemoveHexPrefix removes the prefix (0x) of a given hex string.
 func RemoveHexPrefix(str string) string {
-	if len(str) > 1 && strings.ToLower(str[0:2]) == "0x" {
+	if HasHexPrefix(str) {
 		return str[2:]
 	}
 	return str
 }
 
+// HasHexPrefix returns true if the string starts with 0x.
+func HasHexPrefix(str string) bool {
+	return len(str) > 1 && strings.ToLower(str[0:2]) == "0x"
+}
+
 // DecodeEthereumTx takes an RLP hex encoded Ethereum transaction and
 // returns a Transaction struct with all the fields accessible.
 func DecodeEthereumTx(hex string) (types.Transaction, error) {
@@ -66,11 +66,9 @@ func TestIntegration_HelloWorld(t *testing.T) {
 	j := cltest.CreateHelloWorldJobViaWeb(t, app, mockServer.URL)
 	jr := cltest.WaitForJobRunToPendConfirmations(t, app.Store, cltest.CreateJobRunViaWeb(t, app, j))
 
-	eth.Register("eth_blockNumber", utils.Uint64ToHex(confirmed-1))
 	eth.Register("eth_getTransactionReceipt", store.TxReceipt{})
 	newHeads <- models.BlockHeader{Number: cltest.BigHexInt(confirmed - 1)} // 23459: For Gas Bump
 
-	eth.Register("eth_blockNumber", utils.Uint64ToHex(confirmed))
 	eth.Register("eth_getTransactionReceipt", store.TxReceipt{})
 	eth.Register("eth_getTransactionReceipt", store.TxReceipt{
 		Hash:        hash,
@@ -78,7 +76,6 @@ func TestIntegration_HelloWorld(t *testing.T) {
 	})
 	newHeads <- models.BlockHeader{Number: cltest.BigHexInt(confirmed)} // 23460
 
-	eth.Register("eth_blockNumber", utils.Uint64ToHex(safe))
 	eth.Register("eth_getTransactionReceipt", store.TxReceipt{})
 	eth.Register("eth_getTransactionReceipt", store.TxReceipt{
 		Hash:        hash,
@@ -371,7 +368,7 @@ func TestIntegration_ExternalAdapter_Pending(t *testing.T) {
 		func(body string) {
 			jrs := cltest.WaitForRuns(t, j, app.Store, 1)
 			jr := jrs[0]
-			assert.JSONEq(t, fmt.Sprintf(`{"id":"%v","data":{}}`, jr.ID), body)
+			assert.JSONEq(t, fmt.Sprintf(`{"id":"%v","taskRunId":"%v","data":{}}`, jr.ID, jr.TaskRuns[0].ID), body)
 		})
 	defer cleanup()
 
@@ -50,6 +50,8 @@ func (jrc *JobRunsController) Create(c *gin.Context) {
 		c.AbortWithError(404, errors.New("Job not found"))
 	} else if err != nil {
 		c.AbortWithError(500, err)
+	} else if j.Archived() {
+		c.AbortWithError(410, errors.New("Job has been archived"))
 	} else if !j.WebAuthorized() {
 		c.AbortWithError(403, errors.New("Job not available on web API, recreate with web initiator"))
 	} else if data, err := getRunData(c); err != nil {
@@ -69,8 +71,25 @@ func getRunData(c *gin.Context) (models.JSON, error) {
 	return models.ParseJSON(b)
 }
 
+// Show returns the details of a JobRun, with the input, result, status and
+// timings of each of its tasks.
+// Example:
+//  "<application>/runs/:RunID"
+func (jrc *JobRunsController) Show(c *gin.Context) {
+	id := c.Param("RunID")
+	if jr, err := jrc.App.Store.FindJobRun(id); err == storm.ErrNotFound {
+		c.AbortWithError(404, errors.New("Job Run not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, jr)
+	}
+}
+
 // Update allows external adapters to resume a JobRun, reporting the result of
-// the task and marking it no longer pending.
+// the task and marking it no longer pending. The taskRunId the bridge was
+// called with identifies the task, and is required while the run waits on
+// several bridges.
 // Example:
 //  "<application>/runs/:RunID"
 func (jrc *JobRunsController) Update(c *gin.Context) {
@@ -80,16 +99,69 @@ func (jrc *JobRunsController) Update(c *gin.Context) {
 		c.AbortWithError(404, errors.New("Job Run not found"))
 	} else if err != nil {
 		c.AbortWithError(500, err)
-	} else if !jr.Result.Status.PendingBridge() {
+	} else if pending := jr.PendingBridgeTaskRuns(); len(pending) == 0 {
 		c.AbortWithError(405, errors.New("Cannot resume a job run that isn't pending"))
 	} else if err := c.ShouldBindJSON(&brr); err != nil {
 		c.AbortWithError(500, err)
+	} else if i, err := bridgeTaskRun(jr, pending, brr.TaskRunID); err != nil {
+		c.AbortWithError(422, err)
 	} else {
-		executeRun(jr, jrc.App.Store, brr.RunResult)
+		resumeTaskRun(jr, i, jrc.App, brr.RunResult)
 		c.JSON(200, gin.H{"id": jr.ID})
 	}
 }
 
+// Cancel stops a pending JobRun, moving it to the cancelled status. A run
+// that has broadcast an Ethereum transaction is only cancelled when forced.
+// Example:
+//  "<application>/runs/:RunID/cancel?force=true"
+func (jrc *JobRunsController) Cancel(c *gin.Context) {
+	id := c.Param("RunID")
+	if jr, err := jrc.App.Store.FindJobRun(id); err == storm.ErrNotFound {
+		c.AbortWithError(404, errors.New("Job Run not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if !jr.Status.Pending() {
+		c.AbortWithError(405, errors.New("Cannot cancel a job run that isn't pending"))
+	} else if sent, err := services.EthTxBroadcast(jr, jrc.App.Store); err != nil {
+		c.AbortWithError(500, err)
+	} else if sent && c.Query("force") != "true" {
+		c.AbortWithError(409, errors.New("Job run has broadcast an Ethereum transaction, cancel with force to stop it anyway"))
+	} else if jr, err := services.CancelRun(jr, jrc.App.Store); err != nil {
+		if _, ok := err.(services.JobRunnerError); ok {
+			c.AbortWithError(409, err)
+		} else {
+			c.AbortWithError(500, err)
+		}
+	} else {
+		c.JSON(200, jr)
+	}
+}
+
+// Retry executes an errored JobRun again from its errored tasks onwards,
+// each one starting from the results of the tasks before it.
+// Example:
+//  "<application>/runs/:RunID/retry"
+func (jrc *JobRunsController) Retry(c *gin.Context) {
+	id := c.Param("RunID")
+	if jr, err := jrc.App.Store.FindJobRun(id); err == storm.ErrNotFound {
+		c.AbortWithError(404, errors.New("Job Run not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if !jr.Status.Errored() {
+		c.AbortWithError(405, errors.New("Cannot retry a job run that hasn't errored"))
+	} else if jr, err := services.RetryRun(jr, jrc.App.Store); err != nil {
+		if _, ok := err.(services.JobRunnerError); ok {
+			c.AbortWithError(422, err)
+		} else {
+			c.AbortWithError(500, err)
+		}
+	} else {
+		c.JSON(200, jr)
+		executeRun(jr, jrc.App.Store, models.RunResult{})
+	}
+}
+
 func startJob(j models.JobSpec, s *store.Store, body models.JSON) (models.JobRun, error) {
 	i := j.InitiatorsFor(models.InitiatorWeb)[0]
 	jr, err := services.BuildRun(j, i, s)
@@ -100,6 +172,33 @@ func startJob(j models.JobSpec, s *store.Store, body models.JSON) (models.JobRun
 	return jr, nil
 }
 
+// bridgeTaskRun returns the index of the pending TaskRun a bridge replied
+// for: the one with the given ID, or the only one pending when the bridge
+// gave no ID.
+func bridgeTaskRun(jr models.JobRun, pending []int, taskRunID string) (int, error) {
+	if taskRunID == "" {
+		if len(pending) > 1 {
+			return -1, errors.New("taskRunId is required while several bridges are pending")
+		}
+		return pending[0], nil
+	}
+	for _, i := range pending {
+		if jr.TaskRuns[i].ID == taskRunID {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("Task run %v is not pending on a bridge", taskRunID)
+}
+
+func resumeTaskRun(jr models.JobRun, i int, app *services.ChainlinkApplication, rr models.RunResult) {
+	go func() {
+		bn := app.HeadTracker.LastRecord()
+		if _, err := services.ResumeTaskRun(jr, i, app.Store, rr, bn); err != nil {
+			logger.Error("Bridge callback: ", err.Error())
+		}
+	}()
+}
+
 func executeRun(jr models.JobRun, s *store.Store, rr models.RunResult) {
 	go func() {
 		if _, err := services.ExecuteRun(jr, s, rr); err != nil {
@@ -2,12 +2,15 @@ package web_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
+	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/services"
 	"github.com/smartcontractkit/chainlink/store/models"
 	"github.com/smartcontractkit/chainlink/web"
 	"github.com/stretchr/testify/assert"
@@ -147,6 +150,22 @@ func TestJobRunsController_Create_WithoutWebInitiator(t *testing.T) {
 	assert.Equal(t, 403, resp.StatusCode, "Response should be forbidden")
 }
 
+func TestJobRunsController_Create_Archived(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j, _ := cltest.NewJobWithWebInitiator()
+	assert.Nil(t, app.Store.SaveJob(&j))
+	_, err := app.RemoveJob(j.ID)
+	assert.NoError(t, err)
+
+	url := app.Server.URL + "/v2/specs/" + j.ID + "/runs"
+	resp := cltest.BasicAuthPost(url, "application/json", bytes.NewBuffer([]byte{}))
+	assert.Equal(t, 410, resp.StatusCode, "Response should be gone")
+	cltest.WaitForRuns(t, j, app.Store, 0)
+}
+
 func TestJobRunsController_Create_NotFound(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication()
@@ -157,6 +176,47 @@ func TestJobRunsController_Create_NotFound(t *testing.T) {
 	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
 }
 
+func TestJobRunsController_Show(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask("noop"), cltest.NewTask("nonExistent")}
+	assert.Nil(t, app.Store.Save(&j))
+	input := models.RunResult{Data: cltest.JSONFromString(`{"value":"1"}`)}
+	jr, err := services.ExecuteRun(j.NewRun(initr), app.Store, input)
+	assert.NoError(t, err)
+
+	resp := cltest.BasicAuthGet(app.Server.URL + "/v2/runs/" + jr.ID)
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+	var respRun models.JobRun
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &respRun))
+	assert.Equal(t, jr.ID, respRun.ID)
+	assert.Equal(t, models.RunStatusErrored, respRun.Status)
+	assert.Len(t, respRun.TaskRuns, 2)
+
+	noop := respRun.TaskRuns[0]
+	assert.Equal(t, models.RunStatusCompleted, noop.Status)
+	assert.Equal(t, "1", noop.Input.Get("value").String())
+	assert.Equal(t, "1", noop.Result.Data.Get("value").String())
+	assert.True(t, noop.StartedAt.Valid)
+	assert.True(t, noop.FinishedAt.Valid)
+
+	failed := respRun.TaskRuns[1]
+	assert.Equal(t, models.RunStatusErrored, failed.Status)
+	assert.True(t, failed.Result.HasError())
+}
+
+func TestJobRunsController_Show_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	resp := cltest.BasicAuthGet(app.Server.URL + "/v2/runs/garbage")
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
+
 func TestJobRunsController_Update_Success(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication()
@@ -183,6 +243,47 @@ func TestJobRunsController_Update_Success(t *testing.T) {
 	assert.Equal(t, "100", val)
 }
 
+func TestJobRunsController_Update_ParallelBridges(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	first := cltest.NewBridgeType("firstBridge")
+	assert.Nil(t, app.Store.Save(&first))
+	second := cltest.NewBridgeType("secondBridge")
+	assert.Nil(t, app.Store.Save(&second))
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(first.Name),
+		cltest.NewTask(second.Name),
+		cltest.NewTask("noop"),
+	}
+	j.Tasks[0].ID = "first"
+	j.Tasks[1].ID = "second"
+	j.Tasks[2].Parents = []string{"first", "second"}
+	assert.Nil(t, app.Store.Save(&j))
+	jr := cltest.MarkJobRunPendingBridge(cltest.MarkJobRunPendingBridge(j.NewRun(initr), 0), 1)
+	assert.Nil(t, app.Store.Save(&jr))
+
+	url := app.Server.URL + "/v2/runs/" + jr.ID
+	body := fmt.Sprintf(`{"id":"%v","data":{"value":"second"}}`, jr.ID)
+	resp := cltest.BasicAuthPatch(url, "application/json", bytes.NewBufferString(body))
+	assert.Equal(t, 422, resp.StatusCode, "Response should be unprocessable without a task run id")
+
+	body = fmt.Sprintf(`{"id":"%v","taskRunId":"%v","data":{"value":"second"}}`, jr.ID, jr.TaskRuns[1].ID)
+	resp = cltest.BasicAuthPatch(url, "application/json", bytes.NewBufferString(body))
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() models.RunStatus {
+		assert.Nil(t, app.Store.One("ID", jr.ID, &jr))
+		return jr.TaskRuns[1].Status
+	}).Should(gomega.Equal(models.RunStatusCompleted))
+	assert.Equal(t, "second", jr.TaskRuns[1].Result.Data.Get("value").String())
+	assert.Equal(t, models.RunStatusPendingBridge, jr.TaskRuns[0].Status)
+	assert.False(t, jr.TaskRuns[0].Result.Data.Get("value").Exists())
+}
+
 func TestJobRunsController_Update_NotPending(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication()
@@ -269,3 +370,112 @@ func TestJobRunsController_Update_NotFound(t *testing.T) {
 	assert.Nil(t, app.Store.One("ID", jr.ID, &jr))
 	assert.Equal(t, models.RunStatusPendingBridge, jr.Status)
 }
+
+func TestJobRunsController_Cancel(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	bt := cltest.NewBridgeType()
+	assert.Nil(t, app.Store.Save(&bt))
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{{Type: bt.Name}, {Type: "NoOp"}}
+	assert.Nil(t, app.Store.Save(&j))
+	jr := cltest.MarkJobRunPendingBridge(j.NewRun(initr), 0)
+	assert.Nil(t, app.Store.Save(&jr))
+
+	resp := cltest.BasicAuthPost(app.Server.URL+"/v2/runs/"+jr.ID+"/cancel", "application/json", nil)
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+	var respRun models.JobRun
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &respRun))
+	assert.Equal(t, jr.ID, respRun.ID)
+	assert.Equal(t, models.RunStatusCancelled, respRun.Status)
+
+	jr = cltest.FindJobRun(app.Store, jr.ID)
+	assert.Equal(t, models.RunStatusCancelled, jr.Status)
+	assert.Equal(t, models.RunStatusCancelled, jr.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusCancelled, jr.TaskRuns[1].Status)
+
+	body := fmt.Sprintf(`{"id":"%v","data":{"value": "100"}}`, jr.ID)
+	resp = cltest.BasicAuthPatch(app.Server.URL+"/v2/runs/"+jr.ID, "application/json", bytes.NewBufferString(body))
+	assert.Equal(t, 405, resp.StatusCode, "Response should be unsuccessful")
+
+	resp = cltest.BasicAuthPost(app.Server.URL+"/v2/runs/"+jr.ID+"/cancel", "application/json", nil)
+	assert.Equal(t, 405, resp.StatusCode, "Response should be unsuccessful")
+}
+
+func TestJobRunsController_Cancel_EthTxBroadcast(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{{Type: "EthTx"}}
+	assert.Nil(t, app.Store.Save(&j))
+	tx := cltest.CreateTxAndAttempt(app.Store, cltest.NewAddress(), 1)
+	jr := j.NewRun(initr)
+	jr.TaskRuns[0] = jr.TaskRuns[0].ApplyResult(models.RunResult{}.WithValue(tx.Hash.String()).MarkPendingConfirmations())
+	jr = jr.ApplyResult(jr.TaskRuns[0].Result)
+	assert.Nil(t, app.Store.Save(&jr))
+
+	resp := cltest.BasicAuthPost(app.Server.URL+"/v2/runs/"+jr.ID+"/cancel", "application/json", nil)
+	assert.Equal(t, 409, resp.StatusCode, "Response should be a conflict")
+	jr = cltest.FindJobRun(app.Store, jr.ID)
+	assert.Equal(t, models.RunStatusPendingConfirmations, jr.Status)
+
+	resp = cltest.BasicAuthPost(app.Server.URL+"/v2/runs/"+jr.ID+"/cancel?force=true", "application/json", nil)
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+	jr = cltest.FindJobRun(app.Store, jr.ID)
+	assert.Equal(t, models.RunStatusCancelled, jr.Status)
+}
+
+func TestJobRunsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	resp := cltest.BasicAuthPost(app.Server.URL+"/v2/runs/garbage/cancel", "application/json", nil)
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
+
+func TestJobRunsController_Retry(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	j.Tasks = []models.TaskSpec{cltest.NewTask("noop"), cltest.NewTask("flakyBridge")}
+	assert.Nil(t, app.Store.Save(&j))
+	jr, err := services.ExecuteRun(j.NewRun(initr), app.Store, models.RunResult{})
+	assert.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, jr.Status)
+
+	mockServer, assertCalled := cltest.NewHTTPMockServer(t, 200, "POST", `{"data":{"value":"100"}}`)
+	defer assertCalled()
+	bt := cltest.NewBridgeType("flakyBridge", mockServer.URL)
+	assert.Nil(t, app.Store.Save(&bt))
+
+	resp := cltest.BasicAuthPost(app.Server.URL+"/v2/runs/"+jr.ID+"/retry", "application/json", nil)
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+	var respRun models.JobRun
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &respRun))
+	assert.Equal(t, uint64(1), respRun.Retries)
+
+	jr = cltest.WaitForJobRunToComplete(t, app.Store, jr)
+	assert.Equal(t, uint64(1), jr.Retries)
+	val, err := jr.Result.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "100", val)
+
+	resp = cltest.BasicAuthPost(app.Server.URL+"/v2/runs/"+jr.ID+"/retry", "application/json", nil)
+	assert.Equal(t, 405, resp.StatusCode, "Response should be unsuccessful")
+}
+
+func TestJobRunsController_Retry_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	resp := cltest.BasicAuthPost(app.Server.URL+"/v2/runs/garbage/retry", "application/json", nil)
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
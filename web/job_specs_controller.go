@@ -80,3 +80,82 @@ func (jsc *JobSpecsController) Show(c *gin.Context) {
 		c.JSON(200, presenters.JobSpec{JobSpec: j, Runs: runs})
 	}
 }
+
+// Destroy archives a JobSpec, stopping its initiators while keeping it and
+// its runs.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Destroy(c *gin.Context) {
+	id := c.Param("SpecID")
+	if j, err := jsc.App.Store.FindJob(id); err == storm.ErrNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if j.Archived() {
+		publicError(c, 422, errors.New("JobSpec already archived"))
+	} else if j, err = jsc.App.RemoveJob(id); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, presenters.JobSpec{JobSpec: j})
+	}
+}
+
+// Update makes a new version of a JobSpec from the fields given, keeping
+// its ID and the fields left out, and swaps the initiators of the previous
+// version for the new ones.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Update(c *gin.Context) {
+	id := c.Param("SpecID")
+	j, err := jsc.App.Store.FindJob(id)
+	if err == storm.ErrNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+		return
+	} else if err != nil {
+		c.AbortWithError(500, err)
+		return
+	} else if j.Archived() {
+		publicError(c, 422, errors.New("JobSpec archived"))
+		return
+	}
+
+	update := j
+	update.Initiators, update.Tasks, update.MinPayment = nil, nil, nil
+	if err := c.ShouldBindJSON(&update); err != nil {
+		publicError(c, 400, err)
+		return
+	}
+	if update.Initiators == nil {
+		update.Initiators = j.Initiators
+	}
+	if update.Tasks == nil {
+		update.Tasks = j.Tasks
+	}
+	if update.MinPayment == nil {
+		update.MinPayment = j.MinPayment
+	}
+	next := j.NewVersion(update)
+	if err := services.ValidateJob(next, jsc.App.Store); err != nil {
+		publicError(c, 400, err)
+	} else if err := jsc.App.UpdateJob(j, &next); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, presenters.JobSpec{JobSpec: next})
+	}
+}
+
+// Versions lists the versions of a JobSpec, oldest first.
+// Example:
+//  "<application>/specs/:SpecID/versions"
+func (jsc *JobSpecsController) Versions(c *gin.Context) {
+	id := c.Param("SpecID")
+	if j, err := jsc.App.Store.FindJob(id); err == storm.ErrNotFound {
+		publicError(c, 404, errors.New("JobSpec not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if versions, err := jsc.App.Store.JobSpecVersions(j.ID); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, versions)
+	}
+}
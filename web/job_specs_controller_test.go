@@ -293,3 +293,132 @@ func TestJobSpecsController_Show_Unauthenticated(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 401, resp.StatusCode, "Response should be forbidden")
 }
+
+func TestJobSpecsController_Destroy(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j := setupJobSpecsControllerShow(t, app)
+
+	resp := cltest.BasicAuthDelete(app.Server.URL+"/v2/specs/"+j.ID, "application/json", nil)
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+	var respJob presenters.JobSpec
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &respJob))
+	assert.True(t, respJob.Archived())
+
+	archived, err := app.Store.FindJob(j.ID)
+	assert.NoError(t, err)
+	assert.True(t, archived.Archived())
+	runs, err := app.Store.JobRunsFor(j.ID)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 2)
+
+	resp = cltest.BasicAuthDelete(app.Server.URL+"/v2/specs/"+j.ID, "application/json", nil)
+	assert.Equal(t, 422, resp.StatusCode, "Response should be unprocessable")
+}
+
+func TestJobSpecsController_Destroy_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	resp := cltest.BasicAuthDelete(app.Server.URL+"/v2/specs/garbage", "application/json", nil)
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
+
+func TestJobSpecsController_Update(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j := cltest.FixtureCreateJobViaWeb(t, app, "../internal/fixtures/web/hello_world_job.json")
+
+	jsonStr := cltest.LoadJSON("../internal/fixtures/web/scheduler_job.json")
+	resp := cltest.BasicAuthPatch(app.Server.URL+"/v2/specs/"+j.ID, "application/json", bytes.NewBuffer(jsonStr))
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+	var respJob presenters.JobSpec
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &respJob))
+	assert.Equal(t, j.ID, respJob.ID)
+	assert.Equal(t, uint64(1), respJob.Version)
+
+	updated, err := app.Store.FindJob(j.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.Version)
+	assert.Len(t, updated.InitiatorsFor(models.InitiatorWeb), 0)
+	assert.Len(t, updated.InitiatorsFor(models.InitiatorCron), 1)
+
+	resp = cltest.BasicAuthGet(app.Server.URL + "/v2/specs/" + j.ID + "/versions")
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+	var versions []models.JobSpecVersion
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &versions))
+	assert.Len(t, versions, 2)
+	assert.Equal(t, uint64(0), versions[0].Version)
+	assert.Equal(t, uint64(1), versions[1].Version)
+	assert.Len(t, versions[0].Spec.InitiatorsFor(models.InitiatorWeb), 1)
+}
+
+func TestJobSpecsController_Update_KeepsFieldsLeftOut(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j := cltest.FixtureCreateJobViaWeb(t, app, "../internal/fixtures/web/start_at_job.json")
+
+	body := `{"endAt":"3001-01-01T00:00:00.000Z"}`
+	resp := cltest.BasicAuthPatch(app.Server.URL+"/v2/specs/"+j.ID, "application/json", bytes.NewBufferString(body))
+	assert.Equal(t, 200, resp.StatusCode, "Response should be successful")
+
+	updated, err := app.Store.FindJob(j.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.Version)
+	assert.Equal(t, j.StartAt.Time.Unix(), updated.StartAt.Time.Unix())
+	assert.True(t, updated.EndAt.Valid)
+	assert.Equal(t, 3001, updated.EndAt.Time.Year())
+	assert.Len(t, updated.Tasks, 1)
+	assert.Len(t, updated.Initiators, 1)
+	assert.Equal(t, j.Initiators[0].ID, updated.Initiators[0].ID)
+}
+
+func TestJobSpecsController_Update_InvalidJob(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j := cltest.FixtureCreateJobViaWeb(t, app, "../internal/fixtures/web/hello_world_job.json")
+
+	jsonStr := cltest.LoadJSON("../internal/fixtures/web/invalid_cron.json")
+	resp := cltest.BasicAuthPatch(app.Server.URL+"/v2/specs/"+j.ID, "application/json", bytes.NewBuffer(jsonStr))
+	assert.Equal(t, 400, resp.StatusCode, "Response should be caller error")
+
+	unchanged, err := app.Store.FindJob(j.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), unchanged.Version)
+}
+
+func TestJobSpecsController_Update_Archived(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j := cltest.FixtureCreateJobViaWeb(t, app, "../internal/fixtures/web/hello_world_job.json")
+	_, err := app.RemoveJob(j.ID)
+	assert.NoError(t, err)
+
+	jsonStr := cltest.LoadJSON("../internal/fixtures/web/hello_world_job.json")
+	resp := cltest.BasicAuthPatch(app.Server.URL+"/v2/specs/"+j.ID, "application/json", bytes.NewBuffer(jsonStr))
+	assert.Equal(t, 422, resp.StatusCode, "Response should be unprocessable")
+}
+
+func TestJobSpecsController_Update_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	jsonStr := cltest.LoadJSON("../internal/fixtures/web/hello_world_job.json")
+	resp := cltest.BasicAuthPatch(app.Server.URL+"/v2/specs/garbage", "application/json", bytes.NewBuffer(jsonStr))
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+
+	resp = cltest.BasicAuthGet(app.Server.URL + "/v2/specs/garbage/versions")
+	assert.Equal(t, 404, resp.StatusCode, "Response should be not found")
+}
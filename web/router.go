@@ -57,11 +57,20 @@ func serverEngine(app *services.ChainlinkApplication) *gin.Engine {
 		v2.GET("/specs", j.Index)
 		v2.POST("/specs", j.Create)
 		v2.GET("/specs/:SpecID", j.Show)
+		v2.PATCH("/specs/:SpecID", j.Update)
+		v2.DELETE("/specs/:SpecID", j.Destroy)
+		v2.GET("/specs/:SpecID/versions", j.Versions)
 
 		jr := JobRunsController{app}
 		v2.GET("/specs/:SpecID/runs", jr.Index)
 		v2.POST("/specs/:SpecID/runs", jr.Create)
+		v2.GET("/runs/:RunID", jr.Show)
 		v2.PATCH("/runs/:RunID", jr.Update)
+		v2.POST("/runs/:RunID/cancel", jr.Cancel)
+		v2.POST("/runs/:RunID/retry", jr.Retry)
+
+		rr := RunRequestsController{app}
+		v2.GET("/run_requests/:RequestID", rr.Show)
 
 		tt := BridgeTypesController{app}
 		v2.GET("/bridge_types", tt.Index)
@@ -69,6 +78,12 @@ func serverEngine(app *services.ChainlinkApplication) *gin.Engine {
 		v2.GET("/bridge_types/:BridgeName", tt.Show)
 		v2.DELETE("/bridge_types/:BridgeName", tt.Destroy)
 
+		txs := TransactionsController{app}
+		v2.GET("/transactions", txs.Index)
+		v2.GET("/transactions/:TxHash", txs.Show)
+		v2.POST("/transactions/:TxHash/replace", txs.Replace)
+		v2.POST("/transactions/:TxHash/cancel", txs.Cancel)
+
 		backup := BackupController{app}
 		v2.GET("/backup", backup.Show)
 	}
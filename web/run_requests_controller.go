@@ -0,0 +1,31 @@
+package web
+
+import (
+	"errors"
+
+	"github.com/asdine/storm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/services"
+)
+
+// RunRequestsController shows the requests made through RunLogs and the
+// runs created for them.
+type RunRequestsController struct {
+	App *services.ChainlinkApplication
+}
+
+// Show returns the run request with the given request ID, along with the ID
+// of the run created for it.
+// Example:
+//  "<application>/run_requests/:RequestID"
+func (rrc *RunRequestsController) Show(c *gin.Context) {
+	id := common.HexToHash(c.Param("RequestID")).Hex()
+	if rr, err := rrc.App.Store.FindRunRequest(id); err == storm.ErrNotFound {
+		publicError(c, 404, errors.New("run request not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, rr)
+	}
+}
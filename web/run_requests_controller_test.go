@@ -0,0 +1,38 @@
+package web_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunRequestsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+
+	j, initr := cltest.NewJobWithWebInitiator()
+	assert.NoError(t, app.Store.SaveJob(&j))
+	jr := j.NewRun(initr)
+	assert.NoError(t, app.Store.Save(&jr))
+	rr := models.RunRequest{ID: cltest.NewHash().Hex(), JobID: j.ID, RunID: jr.ID, BlockNumber: 7}
+	assert.NoError(t, app.Store.Save(&rr))
+
+	resp := cltest.BasicAuthGet(app.Server.URL + "/v2/run_requests/" + strings.ToUpper(rr.ID[2:]))
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var found models.RunRequest
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &found))
+	assert.Equal(t, rr.ID, found.ID)
+	assert.Equal(t, j.ID, found.JobID)
+	assert.Equal(t, jr.ID, found.RunID)
+	assert.Equal(t, uint64(7), found.BlockNumber)
+
+	resp = cltest.BasicAuthGet(app.Server.URL + "/v2/run_requests/" + cltest.NewHash().Hex())
+	assert.Equal(t, 404, resp.StatusCode, "Response should be 404")
+}
@@ -0,0 +1,135 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/asdine/storm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/services"
+	"github.com/smartcontractkit/chainlink/store/assets"
+	"github.com/smartcontractkit/chainlink/store/models"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+)
+
+// TransactionsController shows the Ethereum transactions sent by the node.
+type TransactionsController struct {
+	App *services.ChainlinkApplication
+}
+
+// Index lists transactions with their attempts, newest first, one page at a
+// time.
+// Example:
+//  "<application>/transactions"
+func (tc *TransactionsController) Index(c *gin.Context) {
+	size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
+	if err != nil {
+		publicError(c, 422, err)
+		return
+	}
+
+	store := tc.App.Store
+	count, err := store.Count(&models.Tx{})
+	if err != nil {
+		c.AbortWithError(500, fmt.Errorf("error getting count of transactions: %+v", err))
+		return
+	}
+	var txs []models.Tx
+	err = store.AllByIndex("ID", &txs, storm.Skip(offset), storm.Limit(size), storm.Reverse())
+	if err != nil && err != storm.ErrNotFound {
+		c.AbortWithError(500, fmt.Errorf("error fetching all transactions: %+v", err))
+		return
+	}
+
+	ptxs := make([]presenters.Tx, len(txs))
+	for i, tx := range txs {
+		attempts, err := store.AttemptsFor(tx.ID)
+		if err != nil {
+			c.AbortWithError(500, fmt.Errorf("error fetching attempts of transaction %v: %+v", tx.Hash.Hex(), err))
+			return
+		}
+		ptxs[i] = presenters.NewTx(tx, attempts)
+	}
+	buffer, err := NewPaginatedResponse(*c.Request.URL, size, page, count, ptxs)
+	if err != nil {
+		c.AbortWithError(500, fmt.Errorf("failed to marshal document: %+v", err))
+	} else {
+		c.Data(200, MediaType, buffer)
+	}
+}
+
+// Show returns the transaction with an attempt of the given hash, along
+// with all of its attempts.
+// Example:
+//  "<application>/transactions/:TxHash"
+func (tc *TransactionsController) Show(c *gin.Context) {
+	store := tc.App.Store
+	hash := common.HexToHash(c.Param("TxHash"))
+	if tx, err := store.FindTxByAttempt(hash); err == storm.ErrNotFound {
+		publicError(c, 404, errors.New("transaction not found"))
+	} else if err != nil {
+		c.AbortWithError(500, err)
+	} else if attempts, err := store.AttemptsFor(tx.ID); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, presenters.NewTx(*tx, attempts))
+	}
+}
+
+// Replace sends a new attempt of the pending transaction at a higher gas
+// price, given in Wei as the optional "gasPrice" of the request body.
+// Example:
+//  "<application>/transactions/:TxHash/replace"
+func (tc *TransactionsController) Replace(c *gin.Context) {
+	tc.replace(c, tc.App.Store.TxManager.ReplaceTx)
+}
+
+// Cancel replaces the pending transaction with a zero value transfer from
+// its account to itself, at the optional "gasPrice" of the request body.
+// Example:
+//  "<application>/transactions/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	tc.replace(c, tc.App.Store.TxManager.CancelTx)
+}
+
+type txReplacement struct {
+	GasPrice *assets.Eth `json:"gasPrice"`
+}
+
+func (tc *TransactionsController) replace(
+	c *gin.Context,
+	send func(common.Hash, *big.Int) (*models.Tx, error),
+) {
+	store := tc.App.Store
+	hash := common.HexToHash(c.Param("TxHash"))
+	if _, err := store.FindTxByAttempt(hash); err == storm.ErrNotFound {
+		publicError(c, 404, errors.New("transaction not found"))
+		return
+	} else if err != nil {
+		c.AbortWithError(500, err)
+		return
+	}
+
+	var r txReplacement
+	if b, err := ioutil.ReadAll(c.Request.Body); err != nil {
+		c.AbortWithError(500, err)
+		return
+	} else if len(b) > 0 {
+		if err := json.Unmarshal(b, &r); err != nil {
+			publicError(c, 400, err)
+			return
+		}
+	}
+
+	if tx, err := send(hash, (*big.Int)(r.GasPrice)); err != nil {
+		publicError(c, 422, err)
+	} else if attempts, err := store.AttemptsFor(tx.ID); err != nil {
+		c.AbortWithError(500, err)
+	} else {
+		c.JSON(200, presenters.NewTx(*tx, attempts))
+	}
+}
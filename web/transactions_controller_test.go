@@ -0,0 +1,150 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/internal/cltest"
+	"github.com/smartcontractkit/chainlink/store/presenters"
+	"github.com/smartcontractkit/chainlink/utils"
+	"github.com/smartcontractkit/chainlink/web"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	store := app.Store
+
+	first := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 1)
+	second := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 2)
+	_, err := store.AddAttempt(second, second.EthTx(big.NewInt(2)), 3)
+	assert.NoError(t, err)
+
+	resp := cltest.BasicAuthGet(app.Server.URL + "/v2/transactions?size=x")
+	cltest.AssertServerResponse(t, resp, 422)
+
+	resp = cltest.BasicAuthGet(app.Server.URL + "/v2/transactions?size=1")
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var links jsonapi.Links
+	txs := []presenters.Tx{}
+	err = web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &txs, &links)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, links["next"].Href)
+	assert.Empty(t, links["prev"].Href)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, second.Hash, txs[0].Hash)
+	assert.Len(t, txs[0].Attempts, 2)
+	assert.Equal(t, "2", txs[0].Attempts[1].GasPrice)
+	assert.Equal(t, uint64(3), txs[0].Attempts[1].SentAt)
+
+	resp = cltest.BasicAuthGet(app.Server.URL + links["next"].Href)
+	cltest.AssertServerResponse(t, resp, 200)
+
+	txs = []presenters.Tx{}
+	err = web.ParsePaginatedResponse(cltest.ParseResponseBody(resp), &txs, &links)
+	assert.NoError(t, err)
+	assert.Empty(t, links["next"])
+	assert.NotEmpty(t, links["prev"])
+	assert.Len(t, txs, 1)
+	assert.Equal(t, first.Hash, txs[0].Hash)
+	assert.Equal(t, "pending", txs[0].FriendlyStatus())
+}
+
+func TestTransactionsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication()
+	defer cleanup()
+	store := app.Store
+
+	tx := cltest.CreateTxAndAttempt(store, cltest.NewAddress(), 1)
+	firstHash := tx.Hash
+	_, err := store.AddAttempt(tx, tx.EthTx(big.NewInt(2)), 3)
+	assert.NoError(t, err)
+
+	resp := cltest.BasicAuthGet(app.Server.URL + "/v2/transactions/" + firstHash.Hex())
+	cltest.AssertServerResponse(t, resp, 200)
+
+	var ptx presenters.Tx
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &ptx))
+	assert.Equal(t, tx.Hash, ptx.Hash)
+	assert.Equal(t, tx.From, ptx.From)
+	assert.Len(t, ptx.Attempts, 2)
+	assert.Equal(t, firstHash, ptx.Attempts[0].Hash)
+	assert.Equal(t, "1", ptx.Attempts[0].GasPrice)
+	assert.Equal(t, uint64(1), ptx.Attempts[0].SentAt)
+	assert.False(t, ptx.Attempts[0].Confirmed)
+
+	resp = cltest.BasicAuthGet(app.Server.URL + "/v2/transactions/" + cltest.NewHash().Hex())
+	assert.Equal(t, 404, resp.StatusCode, "Response should be 404")
+}
+
+func TestTransactionsController_Replace(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	assert.NoError(t, app.Start())
+
+	tx := cltest.CreateTxAndAttempt(store, cltest.GetAccountAddress(store), 1)
+	url := app.Server.URL + "/v2/transactions/" + tx.Hash.Hex()
+
+	resp := cltest.BasicAuthPost(app.Server.URL+"/v2/transactions/"+cltest.NewHash().Hex()+"/replace", "application/json", bytes.NewBufferString(""))
+	assert.Equal(t, 404, resp.StatusCode, "Response should be 404")
+
+	resp = cltest.BasicAuthPost(url+"/replace", "application/json", bytes.NewBufferString(`{"gasPrice":`))
+	cltest.AssertServerResponse(t, resp, 400)
+
+	resp = cltest.BasicAuthPost(url+"/replace", "application/json", bytes.NewBufferString(`{"gasPrice":"1"}`))
+	cltest.AssertServerResponse(t, resp, 422)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	resp = cltest.BasicAuthPost(url+"/replace", "application/json", bytes.NewBufferString(`{"gasPrice":"30000000000"}`))
+	cltest.AssertServerResponse(t, resp, 200)
+	ethMock.EventuallyAllCalled(t)
+
+	var ptx presenters.Tx
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &ptx))
+	assert.Len(t, ptx.Attempts, 2)
+	assert.Equal(t, "30000000000", ptx.Attempts[1].GasPrice)
+	assert.Equal(t, ptx.Attempts[1].Hash, ptx.Hash)
+}
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKeyStore()
+	defer cleanup()
+	store := app.Store
+	ethMock := app.MockEthClient()
+	ethMock.Register("eth_getTransactionCount", utils.Uint64ToHex(0))
+	assert.NoError(t, app.Start())
+
+	from := cltest.GetAccountAddress(store)
+	tx := cltest.CreateTxAndAttempt(store, from, 1)
+
+	ethMock.Register("eth_blockNumber", utils.Uint64ToHex(10))
+	ethMock.Register("eth_sendRawTransaction", cltest.NewHash())
+	resp := cltest.BasicAuthPost(app.Server.URL+"/v2/transactions/"+tx.Hash.Hex()+"/cancel", "application/json", bytes.NewBufferString(""))
+	cltest.AssertServerResponse(t, resp, 200)
+	ethMock.EventuallyAllCalled(t)
+
+	var ptx presenters.Tx
+	assert.NoError(t, json.Unmarshal(cltest.ParseResponseBody(resp), &ptx))
+	assert.False(t, ptx.Cancelled)
+	assert.Equal(t, "cancelling", ptx.FriendlyStatus())
+	assert.Equal(t, tx.To, ptx.To)
+	assert.Len(t, ptx.Attempts, 2)
+	assert.True(t, ptx.Attempts[1].Cancel)
+}